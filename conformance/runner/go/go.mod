@@ -2,12 +2,15 @@ module github.com/basecamp/basecamp-sdk/conformance/runner/go
 
 go 1.26
 
-require github.com/basecamp/basecamp-sdk/go v0.0.0
+require (
+	github.com/basecamp/basecamp-sdk/go v0.0.0
+	gopkg.in/yaml.v3 v3.0.1
+)
 
 require (
 	github.com/apapsch/go-jsonmerge/v2 v2.0.0 // indirect
 	github.com/google/uuid v1.6.0 // indirect
-	github.com/oapi-codegen/runtime v1.1.2 // indirect
+	github.com/oapi-codegen/runtime v1.6.0 // indirect
 )
 
 replace github.com/basecamp/basecamp-sdk/go => ../../../go
@@ -1,6 +1,6 @@
 // Package main provides a conformance test runner for the Go SDK.
 //
-// This runner reads JSON test definitions from conformance/tests/ and
+// This runner reads JSON (or YAML) test definitions from conformance/tests/ and
 // executes them against the SDK using a mock HTTP server.
 //
 // Unlike earlier iterations, this runner uses the real basecamp.Client
@@ -10,19 +10,24 @@ package main
 
 import (
 	"bytes"
+	"cmp"
 	"context"
 	"encoding/json"
 	"errors"
+	"flag"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"sync"
 	"time"
 
+	"gopkg.in/yaml.v3"
+
 	"github.com/basecamp/basecamp-sdk/go/pkg/basecamp"
 )
 
@@ -45,6 +50,10 @@ type TestCase struct {
 	Assertions      []Assertion            `json:"assertions"`
 	Tags            []string               `json:"tags"`
 	ConfigOverrides *ConfigOverrides       `json:"configOverrides"`
+
+	// ActualResponse is the SDK result decoded back into generic JSON
+	// (maps, slices, json.Number), populated after the operation runs.
+	ActualResponse interface{} `json:"-"`
 }
 
 // ConfigOverrides allows per-test client configuration (e.g., non-localhost baseUrl).
@@ -114,7 +123,74 @@ type TestResult struct {
 	Message string
 }
 
+// suiteCase is a test case tagged with the file it was loaded from.
+type suiteCase struct {
+	File string
+	Test TestCase
+}
+
+// suiteResult is the outcome of a suiteCase. SkipReason is set, and the
+// embedded TestResult only carries Name, when the case was skipped.
+type suiteResult struct {
+	TestResult
+	File       string
+	SkipReason string
+
+	index int // load order, the tiebreak for duplicate names
+}
+
+// runSuite runs cases with at most parallel of them in flight and returns
+// the results sorted by file and test name.
+func runSuite(cases []suiteCase, parallel int) []suiteResult {
+	if parallel < 1 {
+		parallel = 1
+	}
+
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		results = make([]suiteResult, 0, len(cases))
+		sem     = make(chan struct{}, parallel)
+	)
+	for i, c := range cases {
+		if reason, ok := goSDKSkips[c.Test.Name]; ok {
+			results = append(results, suiteResult{
+				TestResult: TestResult{Name: c.Test.Name},
+				File:       c.File,
+				SkipReason: reason,
+				index:      i,
+			})
+			continue
+		}
+
+		sem <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			result := runTest(c.Test)
+
+			mu.Lock()
+			defer mu.Unlock()
+			results = append(results, suiteResult{TestResult: result, File: c.File, index: i})
+		}()
+	}
+	wg.Wait()
+
+	slices.SortFunc(results, func(a, b suiteResult) int {
+		return cmp.Or(
+			cmp.Compare(a.File, b.File),
+			cmp.Compare(a.Name, b.Name),
+			cmp.Compare(a.index, b.index),
+		)
+	})
+	return results
+}
+
 func main() {
+	parallel := flag.Int("parallel", 1, "number of test cases to run concurrently")
+	flag.Parse()
+
 	// Wire-replay mode gate: when WIRE_REPLAY_DIR is set, dispatch to
 	// the replay runner (replay_runner.go) and exit. The replay runner
 	// consumes wire snapshots written by the canonical TS live runner;
@@ -140,56 +216,68 @@ func main() {
 
 	testsDir := filepath.Join("..", "..", "tests")
 
-	files, err := filepath.Glob(filepath.Join(testsDir, "*.json"))
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error finding test files: %v\n", err)
-		os.Exit(1)
+	var files []string
+	for _, pattern := range []string{"*.json", "*.yaml", "*.yml"} {
+		matches, err := filepath.Glob(filepath.Join(testsDir, pattern))
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error finding test files: %v\n", err)
+			os.Exit(1)
+		}
+		files = append(files, matches...)
 	}
+	slices.Sort(files)
 
 	if len(files) == 0 {
 		fmt.Println("No test files found in", testsDir)
 		os.Exit(0)
 	}
 
-	var results []TestResult
-	passed, failed, skipped := 0, 0, 0
-
+	var cases []suiteCase
 	for _, file := range files {
 		tests, err := loadTests(file)
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Error loading %s: %v\n", file, err)
 			continue
 		}
+		for _, tc := range tests {
+			cases = append(cases, suiteCase{File: filepath.Base(file), Test: tc})
+		}
+	}
 
-		fmt.Printf("\n=== %s ===\n", filepath.Base(file))
+	if failed := printResults(os.Stdout, runSuite(cases, *parallel)); failed > 0 {
+		os.Exit(1)
+	}
+}
 
-		for _, tc := range tests {
-			if reason, ok := goSDKSkips[tc.Name]; ok {
-				skipped++
-				fmt.Printf("  SKIP: %s (%s)\n", tc.Name, reason)
-				continue
-			}
+// printResults writes results grouped by file, followed by a summary, and
+// returns the number of failed tests.
+func printResults(w io.Writer, results []suiteResult) int {
+	passed, failed, skipped := 0, 0, 0
+	currentFile := ""
 
-			result := runTest(tc)
-			results = append(results, result)
+	for _, result := range results {
+		if result.File != currentFile {
+			currentFile = result.File
+			fmt.Fprintf(w, "\n=== %s ===\n", currentFile)
+		}
 
-			if result.Passed {
-				passed++
-				fmt.Printf("  PASS: %s\n", tc.Name)
-			} else {
-				failed++
-				sanitized := strings.ReplaceAll(strings.ReplaceAll(result.Message, "\n", " "), "\r", "")
-				fmt.Printf("  FAIL: %s\n        %s\n", tc.Name, sanitized)
-			}
+		switch {
+		case result.SkipReason != "":
+			skipped++
+			fmt.Fprintf(w, "  SKIP: %s (%s)\n", result.Name, result.SkipReason)
+		case result.Passed:
+			passed++
+			fmt.Fprintf(w, "  PASS: %s\n", result.Name)
+		default:
+			failed++
+			sanitized := strings.ReplaceAll(strings.ReplaceAll(result.Message, "\n", " "), "\r", "")
+			fmt.Fprintf(w, "  FAIL: %s\n        %s\n", result.Name, sanitized)
 		}
 	}
 
-	fmt.Printf("\n=== Summary ===\n")
-	fmt.Printf("Passed: %d, Failed: %d, Skipped: %d, Total: %d\n", passed, failed, skipped, passed+failed+skipped)
-
-	if failed > 0 {
-		os.Exit(1)
-	}
+	fmt.Fprintf(w, "\n=== Summary ===\n")
+	fmt.Fprintf(w, "Passed: %d, Failed: %d, Skipped: %d, Total: %d\n", passed, failed, skipped, passed+failed+skipped)
+	return failed
 }
 
 // Tests where the Go SDK's behavior intentionally differs.
@@ -205,8 +293,23 @@ func loadTests(filename string) ([]TestCase, error) {
 	}
 	defer f.Close()
 
+	var r io.Reader = f
+	if ext := filepath.Ext(filename); ext == ".yaml" || ext == ".yml" {
+		// Decode YAML generically and re-encode it as JSON so YAML and
+		// JSON files share TestCase's json tags and json.Number handling.
+		var doc interface{}
+		if err := yaml.NewDecoder(f).Decode(&doc); err != nil {
+			return nil, err
+		}
+		data, err := json.Marshal(doc)
+		if err != nil {
+			return nil, err
+		}
+		r = bytes.NewReader(data)
+	}
+
 	var tests []TestCase
-	dec := json.NewDecoder(f)
+	dec := json.NewDecoder(r)
 	dec.UseNumber() // Preserve large integer precision in Expected values
 	if err := dec.Decode(&tests); err != nil {
 		return nil, err
@@ -369,6 +472,10 @@ func runTest(tc TestCase) TestResult {
 		opResult = executeOperation(context.Background(), account, tc)
 	}()
 
+	if opResult.result != nil {
+		tc.ActualResponse = decodeResult(opResult.result)
+	}
+
 	// Implicit method invariant: the mock server answers any verb, so a
 	// wrong-verb request (e.g. a PUT regressing to POST) would consume a
 	// queued response silently. When the fixture declares a method and
@@ -799,6 +906,20 @@ func checkAssertion(
 			return result
 		}
 
+	case "responseBodyPath":
+		fieldPath := assertion.Path
+		body, ok := tc.ActualResponse.(map[string]interface{})
+		if !ok {
+			return fail(tc, fmt.Sprintf("Expected responseBody.%s, but no object result returned", fieldPath))
+		}
+		actual, present := digPath(body, fieldPath)
+		if !present {
+			return fail(tc, fmt.Sprintf("Expected responseBody.%s, but field not present", fieldPath))
+		}
+		if !jsonEqual(assertion.Expected, actual) {
+			return fail(tc, fmt.Sprintf("Expected responseBody.%s = %s, got %s", fieldPath, jsonString(assertion.Expected), jsonString(actual)))
+		}
+
 	case "requestPath":
 		expected := expectedString(assertion.Expected)
 		idx := assertionIndex(assertion)
@@ -1084,6 +1205,23 @@ func expectedString(v interface{}) string {
 	return fmt.Sprintf("%v", v)
 }
 
+// decodeResult round-trips an SDK result through JSON so assertions can walk
+// it generically. Numbers decode as json.Number to preserve precision.
+// Returns nil if the result cannot be encoded.
+func decodeResult(result interface{}) interface{} {
+	data, err := json.Marshal(result)
+	if err != nil {
+		return nil
+	}
+	var decoded interface{}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&decoded); err != nil {
+		return nil
+	}
+	return decoded
+}
+
 // digPath walks a dot-notation path through nested maps, reporting presence.
 func digPath(obj map[string]interface{}, path string) (interface{}, bool) {
 	var current interface{} = obj
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// delayedSuite builds n GetProject cases whose single mock response is
+// delayed by delay. Names are generated out of order to exercise sorting.
+func delayedSuite(n int, delay time.Duration) []suiteCase {
+	cases := make([]suiteCase, 0, n)
+	for i := n - 1; i >= 0; i-- {
+		cases = append(cases, suiteCase{
+			File: "delayed.json",
+			Test: TestCase{
+				Name:       fmt.Sprintf("case %02d", i),
+				Operation:  "GetProject",
+				Method:     "GET",
+				PathParams: map[string]interface{}{"projectId": json.Number("1")},
+				MockResponses: []MockResponse{{
+					Status: 200,
+					Body:   map[string]interface{}{"id": 1, "name": "Delayed"},
+					Delay:  int(delay / time.Millisecond),
+				}},
+				Assertions: []Assertion{{Type: "noError"}},
+			},
+		})
+	}
+	return cases
+}
+
+func TestRunSuite_ParallelRunsConcurrently(t *testing.T) {
+	const delay = 10 * time.Millisecond
+	cases := delayedSuite(10, delay)
+
+	start := time.Now()
+	results := runSuite(cases, 5)
+	elapsed := time.Since(start)
+
+	// Two waves of five 10 ms cases. Sequential execution would take at
+	// least 100 ms; allow generous headroom for client and server setup.
+	if elapsed < 2*delay {
+		t.Errorf("parallel suite took %v, want at least %v", elapsed, 2*delay)
+	}
+	if elapsed >= 10*delay {
+		t.Errorf("parallel suite took %v, want well under the sequential %v", elapsed, 10*delay)
+	}
+
+	if len(results) != len(cases) {
+		t.Fatalf("got %d results, want %d", len(results), len(cases))
+	}
+	for i, r := range results {
+		if !r.Passed {
+			t.Errorf("%s failed: %s", r.Name, r.Message)
+		}
+		if want := fmt.Sprintf("case %02d", i); r.Name != want {
+			t.Errorf("results[%d].Name = %q, want %q (sorted)", i, r.Name, want)
+		}
+	}
+}
+
+func TestRunSuite_SequentialByDefault(t *testing.T) {
+	const delay = 10 * time.Millisecond
+	cases := delayedSuite(3, delay)
+
+	start := time.Now()
+	results := runSuite(cases, 0)
+	if elapsed := time.Since(start); elapsed < 3*delay {
+		t.Errorf("sequential suite took %v, want at least %v", elapsed, 3*delay)
+	}
+	if len(results) != 3 {
+		t.Fatalf("got %d results, want 3", len(results))
+	}
+}
+
+func TestRunSuite_RecordsSkips(t *testing.T) {
+	var skippedName string
+	for name := range goSDKSkips {
+		skippedName = name
+		break
+	}
+	results := runSuite([]suiteCase{{File: "skips.json", Test: TestCase{Name: skippedName}}}, 4)
+	if len(results) != 1 || results[0].SkipReason == "" {
+		t.Fatalf("results = %+v, want one skipped result", results)
+	}
+}
+
+func TestLoadTests_YAML(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "todos.yaml")
+	doc := `
+# Comments are allowed in YAML test files.
+- name: YAML create todo
+  operation: CreateTodo
+  method: POST
+  path: /todolists/{todolistId}/todos.json
+  pathParams:
+    todolistId: 67890
+  requestBody:
+    content: Buy milk
+  mockResponses:
+    - status: 201
+      body: {id: 111, content: Buy milk}
+  assertions:
+    - type: noError
+`
+	if err := os.WriteFile(path, []byte(doc), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests, err := loadTests(path)
+	if err != nil {
+		t.Fatalf("loadTests() error = %v", err)
+	}
+	if len(tests) != 1 {
+		t.Fatalf("loaded %d tests, want 1", len(tests))
+	}
+	if got := tests[0].PathParams["todolistId"]; got != json.Number("67890") {
+		t.Errorf("pathParams.todolistId = %#v, want json.Number(\"67890\")", got)
+	}
+
+	var out bytes.Buffer
+	failed := printResults(&out, runSuite([]suiteCase{{File: "todos.yaml", Test: tests[0]}}, 1))
+	if failed != 0 {
+		t.Errorf("failed = %d, want 0; output:\n%s", failed, out.String())
+	}
+	if !strings.Contains(out.String(), "PASS: YAML create todo") {
+		t.Errorf("output missing PASS line:\n%s", out.String())
+	}
+}
+
+func TestLoadTests_SampleYAMLMatchesJSONShape(t *testing.T) {
+	tests, err := loadTests(filepath.Join("testdata", "todo_tests.yaml"))
+	if err != nil {
+		t.Fatalf("loadTests() error = %v", err)
+	}
+	if len(tests) == 0 || tests[0].Operation != "CreateTodo" || len(tests[0].Assertions) == 0 {
+		t.Fatalf("tests = %+v, want a CreateTodo case with assertions", tests)
+	}
+}
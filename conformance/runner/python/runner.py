@@ -333,7 +333,7 @@ class TestRunner:
                     elif not error and expected >= 400:
                         failures.append(f"Expected error with status {expected}, but operation succeeded")
 
-                case "responseBody":
+                case "responseBody" | "responseBodyPath":
                     path = assertion.get("path", "")
                     expected = assertion["expected"]
                     actual = _dig_path(result, path)
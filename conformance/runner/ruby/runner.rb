@@ -426,7 +426,7 @@ class TestRunner
         end
         # No error + expected < 400 (2xx/3xx) → success, assertion passes
 
-      when "responseBody"
+      when "responseBody", "responseBodyPath"
         path = assertion["path"]
         expected = assertion["expected"]
         actual = dig_path(result, path)
@@ -925,6 +925,23 @@ function checkAssertions(
         break;
       }
 
+      case "responseBodyPath": {
+        const fieldPath = assertion.path!;
+        const expected = assertion.expected;
+        if (result.result === undefined || result.result === null) {
+          throw new Error(`[${tc.name}] expected responseBody.${fieldPath}, but no result returned`);
+        }
+        let actual: unknown = result.result;
+        for (const key of fieldPath.split(".")) {
+          actual = (actual as Record<string, unknown> | null | undefined)?.[key];
+        }
+        expect(
+          actual,
+          `[${tc.name}] expected responseBody.${fieldPath} = ${JSON.stringify(expected)}, got ${JSON.stringify(actual)}`,
+        ).toEqual(expected);
+        break;
+      }
+
       default:
         throw new Error(
           `[${tc.name}] unknown assertion type: ${assertion.type}`,
@@ -1,7 +1,13 @@
 package basecamp
 
 import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
 	"testing"
+	"time"
 )
 
 func TestForAccount_Validation(t *testing.T) {
@@ -172,3 +178,164 @@ func TestForAccount_ReturnsLightweightClient(t *testing.T) {
 		t.Errorf("AccountClients should share the same generated client")
 	}
 }
+
+func TestForAccountID(t *testing.T) {
+	client := NewClient(DefaultConfig(), &StaticTokenProvider{Token: "test-token"})
+
+	ac := client.ForAccountID(12345)
+	if ac.AccountID() != "12345" {
+		t.Errorf("AccountID() = %q, want %q", ac.AccountID(), "12345")
+	}
+}
+
+func TestAccountClient_Parent(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.BaseURL = "https://example.basecampapi.com"
+	client := NewClient(cfg, &StaticTokenProvider{Token: "test-token"})
+	account := client.ForAccount("12345")
+
+	if account.Parent() != client {
+		t.Error("Parent() should return the Client the account was created from")
+	}
+	if got := account.Parent().Config().BaseURL; got != cfg.BaseURL {
+		t.Errorf("Parent().Config().BaseURL = %q, want %q", got, cfg.BaseURL)
+	}
+	if account.Parent().Authorization() == nil {
+		t.Error("Parent().Authorization() returned nil")
+	}
+}
+
+func TestAccountClient_Reset(t *testing.T) {
+	client := NewClient(DefaultConfig(), &StaticTokenProvider{Token: "test-token"})
+	account := client.ForAccount("12345")
+
+	projects := account.Projects()
+	todos := account.Todos()
+	if account.Projects() != projects {
+		t.Fatal("expected Projects() to return the cached instance before Reset")
+	}
+
+	account.Reset()
+
+	if account.Projects() == projects {
+		t.Error("expected a new ProjectsService after Reset")
+	}
+	if account.Todos() == todos {
+		t.Error("expected a new TodosService after Reset")
+	}
+	if account.AccountID() != "12345" || account.Parent() != client {
+		t.Error("expected Reset to keep the account ID and parent")
+	}
+}
+
+func TestForAccountID_PanicsOnNonPositive(t *testing.T) {
+	client := NewClient(DefaultConfig(), &StaticTokenProvider{Token: "test-token"})
+
+	tests := []struct {
+		accountID int64
+		wantPanic string
+	}{
+		{0, "basecamp: ForAccountID requires positive account ID, got: 0"},
+		{-42, "basecamp: ForAccountID requires positive account ID, got: -42"},
+	}
+
+	for _, tt := range tests {
+		func() {
+			defer func() {
+				r := recover()
+				if r == nil {
+					t.Errorf("ForAccountID(%d) did not panic, expected: %s", tt.accountID, tt.wantPanic)
+				} else if r != tt.wantPanic {
+					t.Errorf("ForAccountID(%d) panic = %v, want %s", tt.accountID, r, tt.wantPanic)
+				}
+			}()
+			client.ForAccountID(tt.accountID)
+		}()
+	}
+}
+
+func TestForAccountID_ConcurrentInitialization(t *testing.T) {
+	client := NewClient(DefaultConfig(), &StaticTokenProvider{Token: "test-token"})
+
+	const n = 50
+	accounts := make([]*AccountClient, n)
+	var wg sync.WaitGroup
+	for i := range n {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			accounts[i] = client.ForAccountID(int64(i + 1))
+		}()
+	}
+	wg.Wait()
+
+	for i, ac := range accounts {
+		if ac.parent.gen == nil || ac.parent.gen != accounts[0].parent.gen {
+			t.Fatalf("account %d does not share the initialized generated client", i)
+		}
+	}
+}
+
+func slowAccountServer(t *testing.T, delay time.Duration) *AccountClient {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(delay)
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusCreated)
+		}
+		_, _ = w.Write([]byte(`{"id":1,"name":"Project"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	cfg := DefaultConfig()
+	cfg.BaseURL = server.URL
+	return NewClient(cfg, &StaticTokenProvider{Token: "test-token"}).ForAccount("12345")
+}
+
+func TestAccountClient_WithTimeout(t *testing.T) {
+	ac := slowAccountServer(t, 200*time.Millisecond)
+	short := ac.WithTimeout(100 * time.Millisecond)
+
+	start := time.Now()
+	_, err := short.Get(context.Background(), "/projects/1.json")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Get error = %v, want context.DeadlineExceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Get took %v; the deadline should bound retries too", elapsed)
+	}
+
+	if _, err := ac.Get(context.Background(), "/projects/1.json"); err != nil {
+		t.Errorf("original client Get: %v", err)
+	}
+	if ac.timeout != 0 || ac.parent.httpOpts.Timeout != DefaultTimeout {
+		t.Error("WithTimeout modified the original client")
+	}
+}
+
+func TestAccountClient_WithTimeout_ServiceMethods(t *testing.T) {
+	ac := slowAccountServer(t, 200*time.Millisecond)
+	short := ac.WithTimeout(100 * time.Millisecond)
+
+	_, err := short.Projects().Create(context.Background(), &CreateProjectRequest{Name: "Project"})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Create error = %v, want context.DeadlineExceeded", err)
+	}
+	if short.Projects() == ac.Projects() {
+		t.Error("derived client should have its own services")
+	}
+
+	if _, err := ac.Projects().Create(context.Background(), &CreateProjectRequest{Name: "Project"}); err != nil {
+		t.Errorf("original client Create: %v", err)
+	}
+}
+
+func TestAccountClient_WithTimeout_PanicsOnNonPositive(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("WithTimeout(0) did not panic")
+		}
+	}()
+	NewClient(DefaultConfig(), &StaticTokenProvider{Token: "test-token"}).ForAccount("12345").WithTimeout(0)
+}
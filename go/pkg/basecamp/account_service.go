@@ -79,6 +79,7 @@ func (s *AccountService) GetAccount(ctx context.Context) (result *Account, err e
 	op := OperationInfo{
 		Service: "Account", Operation: "GetAccount",
 		ResourceType: "account", IsMutation: false,
+		AccountID: s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -110,6 +111,7 @@ func (s *AccountService) UpdateName(ctx context.Context, name string) (result *A
 	op := OperationInfo{
 		Service: "Account", Operation: "UpdateName",
 		ResourceType: "account", IsMutation: true,
+		AccountID: s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -150,6 +152,7 @@ func (s *AccountService) RemoveLogo(ctx context.Context) (err error) {
 	op := OperationInfo{
 		Service: "Account", Operation: "RemoveLogo",
 		ResourceType: "account", IsMutation: true,
+		AccountID: s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -175,6 +178,7 @@ func (s *AccountService) UpdateLogo(ctx context.Context, logo io.Reader, filenam
 	op := OperationInfo{
 		Service: "Account", Operation: "UpdateLogo",
 		ResourceType: "account", IsMutation: true,
+		AccountID: s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
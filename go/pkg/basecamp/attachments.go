@@ -5,6 +5,10 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/basecamp/basecamp-sdk/go/pkg/generated"
@@ -32,6 +36,7 @@ func (s *AttachmentsService) Create(ctx context.Context, filename, contentType s
 	op := OperationInfo{
 		Service: "Attachments", Operation: "Create",
 		ResourceType: "attachment", IsMutation: true,
+		AccountID: s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -83,3 +88,49 @@ func (s *AttachmentsService) Create(ctx context.Context, filename, contentType s
 		AttachableSGID: resp.JSON201.AttachableSgid,
 	}, nil
 }
+
+// CreateFromPath uploads the file at filePath and returns its attachable_sgid.
+// The filename is the base name of filePath. The content type is sniffed from
+// the file's first 512 bytes (see net/http.DetectContentType), falling back to
+// the extension's MIME type when sniffing is inconclusive. Operation hooks
+// observe the underlying Create call.
+func (s *AttachmentsService) CreateFromPath(ctx context.Context, filePath string) (string, error) {
+	if filePath == "" {
+		return "", ErrUsage("file path is required")
+	}
+	data, err := os.ReadFile(filePath) // #nosec G304 -- path is caller-supplied
+	if err != nil {
+		return "", fmt.Errorf("failed to read file: %w", err)
+	}
+	return s.CreateFromBytes(ctx, filepath.Base(filePath), "", data)
+}
+
+// CreateFromBytes uploads data under name and returns its attachable_sgid.
+// If contentType is empty it is detected the same way as CreateFromPath.
+// Operation hooks observe the underlying Create call.
+func (s *AttachmentsService) CreateFromBytes(ctx context.Context, name, contentType string, data []byte) (string, error) {
+	if len(data) == 0 {
+		return "", ErrUsage("file data is required")
+	}
+	if contentType == "" {
+		contentType = detectContentType(name, data)
+	}
+	result, err := s.Create(ctx, name, contentType, bytes.NewReader(data))
+	if err != nil {
+		return "", err
+	}
+	return result.AttachableSGID, nil
+}
+
+// detectContentType sniffs the MIME type of data, using the extension of
+// name when the content alone is not recognized.
+func detectContentType(name string, data []byte) string {
+	sniffed := http.DetectContentType(data)
+	if sniffed != "application/octet-stream" {
+		return sniffed
+	}
+	if byExt := mime.TypeByExtension(filepath.Ext(name)); byExt != "" {
+		return byExt
+	}
+	return sniffed
+}
@@ -3,6 +3,7 @@ package basecamp
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"os"
@@ -115,3 +116,103 @@ func TestAttachmentsService_Create_ContentType(t *testing.T) {
 		})
 	}
 }
+
+func TestAttachmentsService_CreateFromPath(t *testing.T) {
+	tests := []struct {
+		name     string
+		filename string
+		data     []byte
+		want     string
+	}{
+		{"pdf magic bytes", "report.bin", []byte("%PDF-1.7\n%\xe2\xe3\xcf\xd3\n"), "application/pdf"},
+		{"png image", "image", []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR"), "image/png"},
+		{"text file", "notes.txt", []byte("hello, world\n"), "text/plain; charset=utf-8"},
+		{"extension fallback", "scan.webp", []byte{0x00, 0x01, 0x02, 0x03}, "image/webp"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotType, gotName string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotType = r.Header.Get("Content-Type")
+				gotName = r.URL.Query().Get("name")
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusCreated)
+				_, _ = w.Write(loadAttachmentsFixture(t, "create.json"))
+			}))
+			defer server.Close()
+
+			path := filepath.Join(t.TempDir(), tt.filename)
+			if err := os.WriteFile(path, tt.data, 0600); err != nil {
+				t.Fatal(err)
+			}
+
+			cfg := DefaultConfig()
+			cfg.BaseURL = server.URL
+			client := NewClient(cfg, &StaticTokenProvider{Token: "test-token"})
+
+			sgid, err := client.ForAccount("12345").Attachments().CreateFromPath(context.Background(), path)
+			if err != nil {
+				t.Fatalf("CreateFromPath: %v", err)
+			}
+			if sgid == "" {
+				t.Error("expected an attachable_sgid")
+			}
+			if gotType != tt.want {
+				t.Errorf("Content-Type = %q, want %q", gotType, tt.want)
+			}
+			if gotName != tt.filename {
+				t.Errorf("name = %q, want %q", gotName, tt.filename)
+			}
+		})
+	}
+}
+
+func TestAttachmentsService_CreateFromPath_EmptyFile(t *testing.T) {
+	var called bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "empty.txt")
+	if err := os.WriteFile(path, nil, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := DefaultConfig()
+	cfg.BaseURL = server.URL
+	client := NewClient(cfg, &StaticTokenProvider{Token: "test-token"})
+
+	_, err := client.ForAccount("12345").Attachments().CreateFromPath(context.Background(), path)
+	var sdkErr *Error
+	if !errors.As(err, &sdkErr) || sdkErr.Code != CodeUsage {
+		t.Fatalf("expected usage error, got %v", err)
+	}
+	if called {
+		t.Error("expected no request for an empty file")
+	}
+}
+
+func TestAttachmentsService_CreateFromBytes_ExplicitContentType(t *testing.T) {
+	var gotType string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotType = r.Header.Get("Content-Type")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write(loadAttachmentsFixture(t, "create.json"))
+	}))
+	defer server.Close()
+
+	cfg := DefaultConfig()
+	cfg.BaseURL = server.URL
+	client := NewClient(cfg, &StaticTokenProvider{Token: "test-token"})
+
+	_, err := client.ForAccount("12345").Attachments().CreateFromBytes(context.Background(), "data.csv", "text/csv", []byte("a,b\n1,2\n"))
+	if err != nil {
+		t.Fatalf("CreateFromBytes: %v", err)
+	}
+	if gotType != "text/csv" {
+		t.Errorf("Content-Type = %q, want %q", gotType, "text/csv")
+	}
+}
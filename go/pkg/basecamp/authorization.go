@@ -82,6 +82,18 @@ type GetInfoOptions struct {
 	FilterProduct string
 }
 
+// DefaultPingTimeout bounds Client.Ping when PingOptions.Timeout is zero.
+const DefaultPingTimeout = 3 * time.Second
+
+// PingOptions specifies options for Client.PingWithOptions.
+type PingOptions struct {
+	// Endpoint overrides the authorization endpoint, as in GetInfoOptions.
+	Endpoint string
+
+	// Timeout bounds the request. If 0, uses DefaultPingTimeout.
+	Timeout time.Duration
+}
+
 // AuthorizationService handles authorization operations.
 type AuthorizationService struct {
 	client *Client
@@ -170,3 +182,45 @@ func (s *AuthorizationService) GetInfo(ctx context.Context, opts *GetInfoOptions
 
 	return &info, nil
 }
+
+// ListAccounts returns the Basecamp accounts the client's access token can
+// use, without the identity and expiry that GetInfo also returns. opts may be
+// nil; set FilterProduct to "bc3" to list only Basecamp accounts. Like
+// GetInfo, it needs no account, and hooks observe Authorization.GetInfo.
+func (c *Client) ListAccounts(ctx context.Context, opts *GetInfoOptions) ([]AuthorizedAccount, error) {
+	info, err := c.Authorization().GetInfo(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return info.Accounts, nil
+}
+
+// Ping checks that the client's access token is accepted by fetching the
+// authorization endpoint. It returns nil on success, an ErrAuth error when
+// the token is rejected, and an ErrAPI or ErrNetwork error otherwise.
+//
+// Ping does not need an account, so it can run before ForAccount, for example
+// to fail fast before starting a long-running job. The request bypasses the
+// cache and is not retried, and it gives up after DefaultPingTimeout. Hooks
+// observe the Authorization.GetInfo operation.
+func (c *Client) Ping(ctx context.Context) error {
+	return c.PingWithOptions(ctx, nil)
+}
+
+// PingWithOptions is Ping with a custom endpoint or timeout.
+func (c *Client) PingWithOptions(ctx context.Context, opts *PingOptions) error {
+	timeout := DefaultPingTimeout
+	getOpts := &GetInfoOptions{}
+	if opts != nil {
+		if opts.Timeout > 0 {
+			timeout = opts.Timeout
+		}
+		getOpts.Endpoint = opts.Endpoint
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	_, err := c.Authorization().GetInfo(ctx, getOpts)
+	return err
+}
@@ -1,11 +1,14 @@
 package basecamp
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestAuthorizationService_GetInfo(t *testing.T) {
@@ -162,6 +165,133 @@ func TestAuthorizationService_GetInfo_AllowsLocalhostHTTP(t *testing.T) {
 	}
 }
 
+func TestClient_ListAccounts(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"identity": map[string]any{"id": 123},
+			"accounts": []map[string]any{
+				{"id": 999999999, "name": "Honcho Design", "product": "bc3", "href": "https://3.basecampapi.com/999999999"},
+				{"id": 2, "name": "Inbox", "product": "hey"},
+				{"id": 3, "name": "Old Basecamp", "product": "bcx"},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClient(DefaultConfig(), &StaticTokenProvider{Token: "test-token"})
+	endpoint := server.URL + "/authorization.json"
+
+	accounts, err := client.ListAccounts(t.Context(), &GetInfoOptions{Endpoint: endpoint})
+	if err != nil {
+		t.Fatalf("ListAccounts() error = %v", err)
+	}
+	if len(accounts) != 3 {
+		t.Fatalf("expected 3 accounts, got %d", len(accounts))
+	}
+	if accounts[0].ID != 999999999 || accounts[0].HREF != "https://3.basecampapi.com/999999999" {
+		t.Errorf("first account = %+v", accounts[0])
+	}
+
+	accounts, err = client.ListAccounts(t.Context(), &GetInfoOptions{Endpoint: endpoint, FilterProduct: "bc3"})
+	if err != nil {
+		t.Fatalf("ListAccounts(bc3) error = %v", err)
+	}
+	if len(accounts) != 1 || accounts[0].ID != 999999999 {
+		t.Errorf("bc3 accounts = %+v, want only 999999999", accounts)
+	}
+}
+
+func TestClient_ListAccounts_NetworkError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	endpoint := server.URL + "/authorization.json"
+	server.Close()
+
+	client := NewClient(DefaultConfig(), &StaticTokenProvider{Token: "test-token"})
+	accounts, err := client.ListAccounts(t.Context(), &GetInfoOptions{Endpoint: endpoint})
+	if code := ErrorCode(err); code != CodeNetwork {
+		t.Fatalf("error code = %q, want %q (err: %v)", code, CodeNetwork, err)
+	}
+	if accounts != nil {
+		t.Errorf("expected no accounts on error, got %+v", accounts)
+	}
+}
+
+func TestClient_Ping(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		wantCode   string
+	}{
+		{name: "ok", statusCode: http.StatusOK},
+		{name: "unauthorized", statusCode: http.StatusUnauthorized, wantCode: CodeAuth},
+		{name: "unavailable", statusCode: http.StatusServiceUnavailable, wantCode: CodeAPI},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests int
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				if got := r.Header.Get("Authorization"); got != "Bearer test-token" {
+					t.Errorf("Authorization header = %q", got)
+				}
+				w.WriteHeader(tt.statusCode)
+				_ = json.NewEncoder(w).Encode(map[string]any{"identity": map[string]any{"id": 123}})
+			}))
+			defer server.Close()
+
+			client := NewClient(DefaultConfig(), &StaticTokenProvider{Token: "test-token"})
+			err := client.PingWithOptions(t.Context(), &PingOptions{Endpoint: server.URL + "/authorization.json"})
+
+			if tt.wantCode == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			} else if code := ErrorCode(err); code != tt.wantCode {
+				t.Fatalf("error code = %q, want %q (err: %v)", code, tt.wantCode, err)
+			}
+			if requests != 1 {
+				t.Errorf("expected 1 request, got %d", requests)
+			}
+		})
+	}
+}
+
+func TestClient_Ping_ContextCanceled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request should not reach the server")
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	client := NewClient(DefaultConfig(), &StaticTokenProvider{Token: "test-token"})
+	err := client.PingWithOptions(ctx, &PingOptions{Endpoint: server.URL + "/authorization.json"})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+}
+
+func TestClient_Ping_Timeout(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	client := NewClient(DefaultConfig(), &StaticTokenProvider{Token: "test-token"})
+	err := client.PingWithOptions(t.Context(), &PingOptions{
+		Endpoint: server.URL + "/authorization.json",
+		Timeout:  50 * time.Millisecond,
+	})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+	}
+}
+
 // containsStr checks if s contains substr (case-insensitive)
 func containsStr(s, substr string) bool {
 	return len(s) >= len(substr) && (s == substr || len(s) > 0 && containsStrHelper(s, substr))
@@ -0,0 +1,104 @@
+package basecamp
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// DefaultBatchConcurrency is the number of requests batch operations such as
+// TodosService.ReassignBatch run at once when no concurrency is specified.
+const DefaultBatchConcurrency = 5
+
+// BatchOptions specifies options for batch operations.
+type BatchOptions struct {
+	// Concurrency is the maximum number of items processed at once.
+	// If 0 (default), DefaultBatchConcurrency is used.
+	Concurrency int
+}
+
+// BulkOperationError is the failure of one item in a batch operation such as
+// TodosService.ReassignBatch.
+type BulkOperationError struct {
+	ResourceID int64
+	Err        error
+}
+
+// Error implements the error interface.
+func (e BulkOperationError) Error() string {
+	return fmt.Sprintf("resource %d: %v", e.ResourceID, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e BulkOperationError) Unwrap() error {
+	return e.Err
+}
+
+// BulkError reports every item that failed in a batch operation, ordered by
+// ResourceID. Like an errors.Join error, it unwraps to each failure, so
+// errors.Is and errors.As (and helpers such as IsNotFound) look through it.
+type BulkError struct {
+	Errors []BulkOperationError
+}
+
+// Error implements the error interface, one failure per line.
+func (e *BulkError) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i, be := range e.Errors {
+		msgs[i] = be.Error()
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// Unwrap returns each failure for errors.Is and errors.As.
+func (e *BulkError) Unwrap() []error {
+	errs := make([]error, len(e.Errors))
+	for i, be := range e.Errors {
+		errs[i] = be
+	}
+	return errs
+}
+
+// runBatch calls fn for each ID, at most opts.Concurrency at a time, and
+// returns the IDs it succeeded for plus a *BulkError for the rest, both
+// sorted by ID. It attempts every ID even if some fail.
+func runBatch(ids []int64, opts *BatchOptions, fn func(id int64) error) ([]int64, error) {
+	concurrency := DefaultBatchConcurrency
+	if opts != nil && opts.Concurrency > 0 {
+		concurrency = opts.Concurrency
+	}
+
+	var g errgroup.Group
+	g.SetLimit(concurrency)
+
+	var mu sync.Mutex
+	var succeeded []int64
+	var failures []BulkOperationError
+	for _, id := range ids {
+		g.Go(func() error {
+			err := fn(id)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				failures = append(failures, BulkOperationError{ResourceID: id, Err: err})
+			} else {
+				succeeded = append(succeeded, id)
+			}
+			return nil
+		})
+	}
+	_ = g.Wait() // goroutines record failures instead of returning them
+
+	slices.Sort(succeeded)
+	if len(failures) == 0 {
+		return succeeded, nil
+	}
+	slices.SortFunc(failures, func(a, b BulkOperationError) int {
+		return cmp.Compare(a.ResourceID, b.ResourceID)
+	})
+	return succeeded, &BulkError{Errors: failures}
+}
@@ -313,7 +313,7 @@ func BenchmarkConfigLoad(b *testing.B) {
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
 		cfg := DefaultConfig()
-		cfg.LoadConfigFromEnv()
+		_ = cfg.LoadConfigFromEnv()
 	}
 }
 
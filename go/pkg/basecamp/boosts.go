@@ -13,6 +13,21 @@ import (
 // Matches the API's maxPageSize of 50 (from x-basecamp-pagination in the spec).
 const DefaultBoostLimit = 50
 
+// Common boost emoji. Boost content is free-form, so any string is accepted;
+// these cover the reactions most often used in Basecamp.
+const (
+	EmojiThumbsUp  = "👍"
+	EmojiHeart     = "❤️"
+	EmojiRocket    = "🚀"
+	EmojiParty     = "🎉"
+	EmojiClap      = "👏"
+	EmojiLaugh     = "😂"
+	EmojiEyes      = "👀"
+	EmojiCheckMark = "✅"
+	EmojiFire      = "🔥"
+	Emoji100       = "💯"
+)
+
 // Boost represents a Basecamp boost (emoji reaction) on a recording.
 type Boost struct {
 	ID        int64     `json:"id"`
@@ -66,6 +81,7 @@ func (s *BoostsService) ListRecording(ctx context.Context, recordingID int64, op
 		Service: "Boosts", Operation: "ListRecording",
 		ResourceType: "boost", IsMutation: false,
 		ResourceID: recordingID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -85,6 +101,7 @@ func (s *BoostsService) ListRecording(ctx context.Context, recordingID int64, op
 	}
 
 	totalCount := parseTotalCount(resp.HTTPResponse)
+	totalPages := parseTotalPages(resp.HTTPResponse, firstPageLen(resp.JSON200))
 
 	// Parse first page
 	var boosts []Boost
@@ -96,7 +113,7 @@ func (s *BoostsService) ListRecording(ctx context.Context, recordingID int64, op
 
 	// Handle single page fetch (--page flag)
 	if opts != nil && opts.Page > 0 {
-		return &BoostListResult{Boosts: boosts, Meta: ListMeta{TotalCount: totalCount}}, nil
+		return &BoostListResult{Boosts: boosts, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages}}, nil
 	}
 
 	// Determine limit: 0 = default (50), -1 = unlimited, >0 = specific limit
@@ -111,7 +128,7 @@ func (s *BoostsService) ListRecording(ctx context.Context, recordingID int64, op
 
 	// Check if we already have enough items
 	if limit > 0 && len(boosts) >= limit {
-		return &BoostListResult{Boosts: boosts[:limit], Meta: ListMeta{TotalCount: totalCount, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(boosts), limit)}}, nil
+		return &BoostListResult{Boosts: boosts[:limit], Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(boosts), limit)}}, nil
 	}
 
 	// Follow pagination via Link headers
@@ -129,7 +146,7 @@ func (s *BoostsService) ListRecording(ctx context.Context, recordingID int64, op
 		boosts = append(boosts, boostFromGenerated(gb))
 	}
 
-	return &BoostListResult{Boosts: boosts, Meta: ListMeta{TotalCount: totalCount, Truncated: truncated}}, nil
+	return &BoostListResult{Boosts: boosts, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: truncated}}, nil
 }
 
 // ListEvent returns boosts on a specific event within a recording.
@@ -147,6 +164,7 @@ func (s *BoostsService) ListEvent(ctx context.Context, recordingID, eventID int6
 		Service: "Boosts", Operation: "ListEvent",
 		ResourceType: "boost", IsMutation: false,
 		ResourceID: eventID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -166,6 +184,7 @@ func (s *BoostsService) ListEvent(ctx context.Context, recordingID, eventID int6
 	}
 
 	totalCount := parseTotalCount(resp.HTTPResponse)
+	totalPages := parseTotalPages(resp.HTTPResponse, firstPageLen(resp.JSON200))
 
 	// Parse first page
 	var boosts []Boost
@@ -177,7 +196,7 @@ func (s *BoostsService) ListEvent(ctx context.Context, recordingID, eventID int6
 
 	// Handle single page fetch (--page flag)
 	if opts != nil && opts.Page > 0 {
-		return &BoostListResult{Boosts: boosts, Meta: ListMeta{TotalCount: totalCount}}, nil
+		return &BoostListResult{Boosts: boosts, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages}}, nil
 	}
 
 	// Determine limit: 0 = default (50), -1 = unlimited, >0 = specific limit
@@ -192,7 +211,7 @@ func (s *BoostsService) ListEvent(ctx context.Context, recordingID, eventID int6
 
 	// Check if we already have enough items
 	if limit > 0 && len(boosts) >= limit {
-		return &BoostListResult{Boosts: boosts[:limit], Meta: ListMeta{TotalCount: totalCount, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(boosts), limit)}}, nil
+		return &BoostListResult{Boosts: boosts[:limit], Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(boosts), limit)}}, nil
 	}
 
 	// Follow pagination via Link headers
@@ -210,7 +229,7 @@ func (s *BoostsService) ListEvent(ctx context.Context, recordingID, eventID int6
 		boosts = append(boosts, boostFromGenerated(gb))
 	}
 
-	return &BoostListResult{Boosts: boosts, Meta: ListMeta{TotalCount: totalCount, Truncated: truncated}}, nil
+	return &BoostListResult{Boosts: boosts, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: truncated}}, nil
 }
 
 // Get returns a boost by ID.
@@ -219,6 +238,7 @@ func (s *BoostsService) Get(ctx context.Context, boostID int64) (result *Boost,
 		Service: "Boosts", Operation: "Get",
 		ResourceType: "boost", IsMutation: false,
 		ResourceID: boostID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -253,6 +273,7 @@ func (s *BoostsService) CreateRecording(ctx context.Context, recordingID int64,
 		Service: "Boosts", Operation: "CreateRecording",
 		ResourceType: "boost", IsMutation: true,
 		ResourceID: recordingID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -296,6 +317,7 @@ func (s *BoostsService) CreateEvent(ctx context.Context, recordingID, eventID in
 		Service: "Boosts", Operation: "CreateEvent",
 		ResourceType: "boost", IsMutation: true,
 		ResourceID: eventID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -337,6 +359,7 @@ func (s *BoostsService) Delete(ctx context.Context, boostID int64) (err error) {
 		Service: "Boosts", Operation: "Delete",
 		ResourceType: "boost", IsMutation: true,
 		ResourceID: boostID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -354,6 +377,46 @@ func (s *BoostsService) Delete(ctx context.Context, boostID int64) (err error) {
 	return checkResponse(resp.HTTPResponse, resp.Body)
 }
 
+// Toggle adds or removes the current user's emoji boost on a recording.
+// If the current user has already boosted the recording with emoji, that
+// boost is deleted and created is false; otherwise a new boost is created
+// and created is true. Boosts with the same emoji from other people are
+// left alone.
+//
+// Toggle is a composite of PeopleService.Me, ListRecording and either
+// CreateRecording or Delete. It does not emit its own operation hooks;
+// hooks observe each underlying call. Toggle is not atomic: a concurrent
+// change between the list and the mutation can make it create a duplicate
+// or fail to find the boost it listed.
+func (s *BoostsService) Toggle(ctx context.Context, recordingID int64, emoji string) (created bool, err error) {
+	if emoji == "" {
+		return false, ErrUsage("boost content is required")
+	}
+
+	me, err := s.client.People().Me(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	existing, err := s.ListRecording(ctx, recordingID, &BoostListOptions{Limit: -1})
+	if err != nil {
+		return false, err
+	}
+	for _, b := range existing.Boosts {
+		if b.Content == emoji && b.Booster != nil && b.Booster.ID == me.ID {
+			if err := s.Delete(ctx, b.ID); err != nil {
+				return false, err
+			}
+			return false, nil
+		}
+	}
+
+	if _, err := s.CreateRecording(ctx, recordingID, emoji); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 // boostFromGenerated converts a generated Boost to our clean Boost type.
 func boostFromGenerated(gb generated.Boost) Boost {
 	b := Boost{
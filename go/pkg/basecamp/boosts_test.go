@@ -531,3 +531,76 @@ func TestBoostsService_Delete_NotFound(t *testing.T) {
 		t.Errorf("expected not_found error, got: %v", err)
 	}
 }
+
+// toggleBoostsHandler serves the current user's profile and the boosts list
+// fixture, and records any mutating request it receives.
+func toggleBoostsHandler(t *testing.T, mutations *[]string) http.HandlerFunc {
+	list := loadBoostsFixture(t, "list.json")
+	get := loadBoostsFixture(t, "get.json")
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/99999/my/profile.json":
+			w.Write([]byte(`{"id": 1049715914, "name": "Victor Cooper"}`))
+		case r.Method == "GET" && r.URL.Path == "/99999/recordings/200/boosts.json":
+			w.Write(list)
+		case r.Method == "POST" && r.URL.Path == "/99999/recordings/200/boosts.json":
+			var body map[string]any
+			json.NewDecoder(r.Body).Decode(&body)
+			*mutations = append(*mutations, fmt.Sprintf("POST %v", body["content"]))
+			w.WriteHeader(201)
+			w.Write(get)
+		case r.Method == "DELETE":
+			*mutations = append(*mutations, "DELETE "+r.URL.Path)
+			w.WriteHeader(204)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(500)
+		}
+	}
+}
+
+func TestBoostsService_Toggle_DeletesOwnBoost(t *testing.T) {
+	var mutations []string
+	svc := testBoostsServer(t, toggleBoostsHandler(t, &mutations))
+
+	created, err := svc.Toggle(context.Background(), 200, EmojiParty)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if created {
+		t.Error("expected created=false when removing an existing boost")
+	}
+	if len(mutations) != 1 || mutations[0] != "DELETE /99999/boosts/1069479500" {
+		t.Errorf("mutations = %v, want [DELETE /99999/boosts/1069479500]", mutations)
+	}
+}
+
+func TestBoostsService_Toggle_CreatesWhenOnlyOthersBoosted(t *testing.T) {
+	var mutations []string
+	svc := testBoostsServer(t, toggleBoostsHandler(t, &mutations))
+
+	// The fixture has a 👍 from another person; it must not be deleted.
+	created, err := svc.Toggle(context.Background(), 200, EmojiThumbsUp)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !created {
+		t.Error("expected created=true when adding a new boost")
+	}
+	if len(mutations) != 1 || mutations[0] != "POST "+EmojiThumbsUp {
+		t.Errorf("mutations = %v, want [POST %s]", mutations, EmojiThumbsUp)
+	}
+}
+
+func TestBoostsService_Toggle_EmptyEmoji(t *testing.T) {
+	svc := testBoostsServer(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+	})
+
+	_, err := svc.Toggle(context.Background(), 200, "")
+	apiErr, ok := errors.AsType[*Error](err)
+	if !ok || apiErr.Code != CodeUsage {
+		t.Errorf("expected usage error, got: %v", err)
+	}
+}
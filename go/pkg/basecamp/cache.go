@@ -6,13 +6,44 @@ import (
 	"encoding/json"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync"
+	"sync/atomic"
 )
 
 // Cache provides ETag-based HTTP caching.
 type Cache struct {
 	dir string
 	mu  sync.RWMutex
+
+	hits      atomic.Int64
+	misses    atomic.Int64
+	stores    atomic.Int64
+	evictions atomic.Int64
+
+	// size is the total size of cached bodies, measured from disk on first
+	// use (sizeOnce) and then adjusted by set, removeKeys, and Clear.
+	sizeOnce sync.Once
+	size     atomic.Int64
+}
+
+// CacheStats is a snapshot of cache activity since the cache was created
+// or since the last call to ResetStats.
+type CacheStats struct {
+	// Hits counts cached GET requests answered from the cache: the server
+	// returned 304 Not Modified and the cached body was available.
+	Hits int64
+	// Misses counts cached GET requests that were not: no ETag was stored,
+	// the ETag was stale, or the cached body was missing.
+	Misses int64
+	// Stores counts successful Set calls.
+	Stores int64
+	// Evictions counts entries removed by Clear, Invalidate, InvalidateURL,
+	// or InvalidateByPrefix.
+	Evictions int64
+	// SizeBytes is the total size of cached response bodies. It is read from
+	// disk once, then kept up to date as entries are stored and removed.
+	SizeBytes int64
 }
 
 // NewCache creates a new cache with the given directory.
@@ -20,20 +51,30 @@ func NewCache(dir string) *Cache {
 	return &Cache{dir: dir}
 }
 
+// cacheIndexEntry records the request a cache key was derived from, so that
+// entries can be found again by URL. Keys are one-way hashes.
+type cacheIndexEntry struct {
+	URL       string `json:"url"`
+	TokenHash string `json:"token_hash"`
+}
+
 // Key generates a cache key for a URL, account, and token.
 // The key includes a token hash to ensure different auth contexts don't share cache.
 func (c *Cache) Key(url, accountID, token string) string {
-	tokenHash := ""
-	if token != "" {
-		h := sha256.Sum256([]byte(token))
-		tokenHash = hex.EncodeToString(h[:8]) // First 16 chars
-	}
-
-	input := url + ":" + accountID + ":" + tokenHash
+	input := url + ":" + accountID + ":" + tokenHash(token)
 	h := sha256.Sum256([]byte(input))
 	return hex.EncodeToString(h[:])
 }
 
+// tokenHash returns the truncated token digest mixed into cache keys.
+func tokenHash(token string) string {
+	if token == "" {
+		return ""
+	}
+	h := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(h[:8]) // First 16 chars
+}
+
 // GetETag returns the cached ETag for a key, or empty string if not found.
 func (c *Cache) GetETag(key string) string {
 	c.mu.RLock()
@@ -66,10 +107,47 @@ func (c *Cache) GetBody(key string) []byte {
 	return data
 }
 
+// recordLookup counts one cached GET request as a hit or a miss.
+func (c *Cache) recordLookup(hit bool) {
+	if hit {
+		c.hits.Add(1)
+	} else {
+		c.misses.Add(1)
+	}
+}
+
+// loadSize measures the cached bodies already on disk the first time it is
+// called. The caller must hold c.mu.
+func (c *Cache) loadSize() {
+	c.sizeOnce.Do(func() {
+		c.size.Store(bodiesSize(filepath.Join(c.dir, "responses")))
+	})
+}
+
+// fileSize returns the size of the file at path, or 0 if it does not exist.
+func fileSize(path string) int64 {
+	info, err := os.Stat(path) // #nosec G703 -- cache dir is caller-configured
+	if err != nil {
+		return 0
+	}
+	return info.Size()
+}
+
 // Set stores a response body and ETag for a key.
 func (c *Cache) Set(key string, body []byte, etag string) error {
+	return c.set(key, body, etag, nil)
+}
+
+// setURL is Set for a key derived from Key(url, "", token). It also records
+// the URL so the entry can later be removed by InvalidateByPrefix.
+func (c *Cache) setURL(key, url, token string, body []byte, etag string) error {
+	return c.set(key, body, etag, &cacheIndexEntry{URL: url, TokenHash: tokenHash(token)})
+}
+
+func (c *Cache) set(key string, body []byte, etag string, entry *cacheIndexEntry) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
+	c.loadSize()
 
 	// Ensure directories exist
 	responsesDir := filepath.Join(c.dir, "responses")
@@ -83,10 +161,12 @@ func (c *Cache) Set(key string, body []byte, etag string) error {
 	if err := os.WriteFile(tmpFile, body, 0600); err != nil { // #nosec G703 -- cache dir is caller-configured
 		return err
 	}
+	oldSize := fileSize(bodyFile)
 	if err := os.Rename(tmpFile, bodyFile); err != nil { // #nosec G703 -- cache dir is caller-configured
 		_ = os.Remove(tmpFile) // #nosec G703 -- cache dir is caller-configured
 		return err
 	}
+	c.size.Add(int64(len(body)) - oldSize)
 
 	// Update etags.json
 	etagsFile := filepath.Join(c.dir, "etags.json")
@@ -114,6 +194,15 @@ func (c *Cache) Set(key string, body []byte, etag string) error {
 		return err
 	}
 
+	if entry != nil {
+		index := c.readIndex()
+		index[key] = *entry
+		if err := c.writeIndex(index); err != nil {
+			return err
+		}
+	}
+
+	c.stores.Add(1)
 	return nil
 }
 
@@ -123,12 +212,17 @@ func (c *Cache) Clear() error {
 	defer c.mu.Unlock()
 
 	responsesDir := filepath.Join(c.dir, "responses")
+	removed := countBodies(responsesDir)
 	if err := os.RemoveAll(responsesDir); err != nil {
 		return err
 	}
+	c.evictions.Add(removed)
+	c.sizeOnce.Do(func() {})
+	c.size.Store(0)
 
 	etagsFile := filepath.Join(c.dir, "etags.json")
 	_ = os.Remove(etagsFile)
+	_ = os.Remove(filepath.Join(c.dir, "index.json"))
 
 	return nil
 }
@@ -138,24 +232,180 @@ func (c *Cache) Invalidate(key string) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	// Remove body file
-	bodyFile := filepath.Join(c.dir, "responses", key+".body")
-	_ = os.Remove(bodyFile)
+	return c.removeKeys([]string{key})
+}
+
+// InvalidateURL removes the cached response for url under the given token.
+// token is the same credential string passed to Key; the Client uses the
+// request's Authorization header value.
+func (c *Cache) InvalidateURL(url, token string) error {
+	return c.Invalidate(c.Key(url, "", token))
+}
+
+// InvalidateByPrefix removes every cached response stored by the Client whose
+// URL starts with urlPrefix and whose key was derived from token. Entries
+// written directly with Set are not indexed by URL and are left untouched.
+func (c *Cache) InvalidateByPrefix(urlPrefix, token string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	hash := tokenHash(token)
+	var keys []string
+	for key, entry := range c.readIndex() {
+		if entry.TokenHash == hash && strings.HasPrefix(entry.URL, urlPrefix) {
+			keys = append(keys, key)
+		}
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	return c.removeKeys(keys)
+}
+
+// URLForKey returns the URL a cached response was fetched from, or an empty
+// string if key is unknown or was written directly with Set. Cache keys are
+// one-way hashes; this is a diagnostic aid for inspecting the cache directory.
+func (c *Cache) URLForKey(key string) string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.readIndex()[key].URL
+}
+
+// removeKeys deletes the bodies, ETags, and index entries for keys.
+// The caller must hold c.mu.
+func (c *Cache) removeKeys(keys []string) error {
+	c.loadSize()
+	for _, key := range keys {
+		bodyFile := filepath.Join(c.dir, "responses", key+".body")
+		size := fileSize(bodyFile)
+		if os.Remove(bodyFile) == nil { // #nosec G703 -- cache dir is caller-configured
+			c.evictions.Add(1)
+			c.size.Add(-size)
+		}
+	}
 
 	// Remove from etags.json
 	etagsFile := filepath.Join(c.dir, "etags.json")
 	etags := make(map[string]string)
 
-	if data, err := os.ReadFile(etagsFile); err == nil {
+	if data, err := os.ReadFile(etagsFile); err == nil { // #nosec G703 -- cache dir is caller-configured
 		_ = json.Unmarshal(data, &etags) // Ignore parse errors, start fresh
 	}
 
-	delete(etags, key)
+	etagRemoved := false
+	for _, key := range keys {
+		if _, ok := etags[key]; ok {
+			delete(etags, key)
+			etagRemoved = true
+		}
+	}
 
-	data, err := json.MarshalIndent(etags, "", "  ")
+	if etagRemoved {
+		data, err := json.MarshalIndent(etags, "", "  ")
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(etagsFile, data, 0600); err != nil { // #nosec G703 -- cache dir is caller-configured
+			return err
+		}
+	}
+
+	index := c.readIndex()
+	removed := false
+	for _, key := range keys {
+		if _, ok := index[key]; ok {
+			delete(index, key)
+			removed = true
+		}
+	}
+	if !removed {
+		return nil
+	}
+	return c.writeIndex(index)
+}
+
+// readIndex loads the key-to-URL index. The caller must hold c.mu.
+func (c *Cache) readIndex() map[string]cacheIndexEntry {
+	index := make(map[string]cacheIndexEntry)
+	if data, err := os.ReadFile(filepath.Join(c.dir, "index.json")); err == nil { // #nosec G703 -- cache dir is caller-configured
+		_ = json.Unmarshal(data, &index) // Ignore parse errors, start fresh
+	}
+	return index
+}
+
+// writeIndex atomically replaces the key-to-URL index. The caller must hold c.mu.
+func (c *Cache) writeIndex(index map[string]cacheIndexEntry) error {
+	data, err := json.MarshalIndent(index, "", "  ")
 	if err != nil {
 		return err
 	}
 
-	return os.WriteFile(etagsFile, data, 0600)
+	indexFile := filepath.Join(c.dir, "index.json")
+	tmpIndex := indexFile + ".tmp"
+	if err := os.WriteFile(tmpIndex, data, 0600); err != nil { // #nosec G703 -- cache dir is caller-configured
+		return err
+	}
+	if err := os.Rename(tmpIndex, indexFile); err != nil { // #nosec G703 -- cache dir is caller-configured
+		_ = os.Remove(tmpIndex) // #nosec G703 -- cache dir is caller-configured
+		return err
+	}
+	return nil
+}
+
+// Stats returns a snapshot of the cache's counters and current size. Only
+// the first call reads the cache directory.
+func (c *Cache) Stats() CacheStats {
+	c.mu.RLock()
+	c.loadSize()
+	c.mu.RUnlock()
+
+	return CacheStats{
+		Hits:      c.hits.Load(),
+		Misses:    c.misses.Load(),
+		Stores:    c.stores.Load(),
+		Evictions: c.evictions.Load(),
+		SizeBytes: c.size.Load(),
+	}
+}
+
+// ResetStats zeroes the hit, miss, store, and eviction counters.
+func (c *Cache) ResetStats() {
+	c.hits.Store(0)
+	c.misses.Store(0)
+	c.stores.Store(0)
+	c.evictions.Store(0)
+}
+
+// countBodies returns the number of cached response bodies in dir.
+func countBodies(dir string) int64 {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return 0
+	}
+	var n int64
+	for _, e := range entries {
+		if !e.IsDir() && strings.HasSuffix(e.Name(), ".body") {
+			n++
+		}
+	}
+	return n
+}
+
+// bodiesSize returns the total size in bytes of cached response bodies in dir.
+func bodiesSize(dir string) int64 {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return 0
+	}
+	var total int64
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), ".body") {
+			continue
+		}
+		if info, err := e.Info(); err == nil {
+			total += info.Size()
+		}
+	}
+	return total
 }
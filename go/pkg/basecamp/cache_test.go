@@ -1,8 +1,12 @@
 package basecamp
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync"
 	"testing"
 )
@@ -94,6 +98,109 @@ func TestCache_Invalidate(t *testing.T) {
 	}
 }
 
+func TestCache_InvalidateURL(t *testing.T) {
+	c := NewCache(t.TempDir())
+	url := "https://example.com/99999/todos/1.json"
+	mine := c.Key(url, "", "Bearer mine")
+	theirs := c.Key(url, "", "Bearer theirs")
+
+	_ = c.setURL(mine, url, "Bearer mine", []byte("mine"), `"e1"`)
+	_ = c.setURL(theirs, url, "Bearer theirs", []byte("theirs"), `"e2"`)
+
+	if err := c.InvalidateURL(url, "Bearer mine"); err != nil {
+		t.Fatalf("InvalidateURL: %v", err)
+	}
+
+	if got := c.GetBody(mine); got != nil {
+		t.Errorf("GetBody invalidated = %q, want nil", got)
+	}
+	if got := c.GetBody(theirs); string(got) != "theirs" {
+		t.Errorf("GetBody other token = %q, want %q", got, "theirs")
+	}
+}
+
+func TestCache_InvalidateByPrefix(t *testing.T) {
+	c := NewCache(t.TempDir())
+	const token = "Bearer t"
+	urls := []string{
+		"https://example.com/99999/todolists/1/todos.json",
+		"https://example.com/99999/todolists/1/todos.json?page=2",
+		"https://example.com/99999/todolists/2/todos.json",
+	}
+	for _, u := range urls {
+		_ = c.setURL(c.Key(u, "", token), u, token, []byte(u), `"e"`)
+	}
+	otherToken := c.Key(urls[0], "", "Bearer other")
+	_ = c.setURL(otherToken, urls[0], "Bearer other", []byte("other"), `"e"`)
+	_ = c.Set("unindexed", []byte("raw"), `"e"`)
+	c.ResetStats()
+
+	if err := c.InvalidateByPrefix("https://example.com/99999/todolists/1/", token); err != nil {
+		t.Fatalf("InvalidateByPrefix: %v", err)
+	}
+
+	for _, u := range urls[:2] {
+		if got := c.GetETag(c.Key(u, "", token)); got != "" {
+			t.Errorf("GetETag(%s) = %q, want empty", u, got)
+		}
+	}
+	if got := c.GetBody(c.Key(urls[2], "", token)); string(got) != urls[2] {
+		t.Errorf("GetBody outside prefix = %q, want %q", got, urls[2])
+	}
+	if got := c.GetBody(otherToken); string(got) != "other" {
+		t.Errorf("GetBody other token = %q, want %q", got, "other")
+	}
+	if got := c.GetBody("unindexed"); string(got) != "raw" {
+		t.Errorf("GetBody unindexed = %q, want %q", got, "raw")
+	}
+	if got := c.Stats().Evictions; got != 2 {
+		t.Errorf("Evictions = %d, want 2", got)
+	}
+}
+
+func TestClient_MutationInvalidatesCachedGet(t *testing.T) {
+	var gets, puts int
+	var conditional []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			gets++
+			conditional = append(conditional, r.Header.Get("If-None-Match"))
+			w.Header().Set("ETag", `"v1"`)
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"id":1}`))
+		case http.MethodPut:
+			puts++
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"id":1}`))
+		}
+	}))
+	defer server.Close()
+
+	cfg := &Config{BaseURL: server.URL}
+	client := NewClient(cfg, &StaticTokenProvider{Token: "test-token"}, WithCache(NewCache(t.TempDir())))
+	ctx := context.Background()
+
+	if _, err := client.Get(ctx, "/todos/1.json"); err != nil {
+		t.Fatalf("first Get: %v", err)
+	}
+	if _, err := client.Put(ctx, "/todos/1.json", map[string]any{"content": "x"}); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+	if _, err := client.Get(ctx, "/todos/1.json"); err != nil {
+		t.Fatalf("second Get: %v", err)
+	}
+
+	if gets != 2 || puts != 1 {
+		t.Fatalf("server saw %d GETs and %d PUTs, want 2 and 1", gets, puts)
+	}
+	for i, etag := range conditional {
+		if etag != "" {
+			t.Errorf("GET %d sent If-None-Match %q; the cached entry should have been invalidated", i+1, etag)
+		}
+	}
+}
+
 func TestCache_NamespaceSeparation(t *testing.T) {
 	c := NewCache(t.TempDir())
 
@@ -157,3 +264,202 @@ func TestCache_CorruptedEtagsJSON(t *testing.T) {
 		t.Errorf("GetETag after fix = %q, want %q", got, `"fresh"`)
 	}
 }
+
+func TestCache_Stats(t *testing.T) {
+	t.Parallel()
+	c := NewCache(t.TempDir())
+
+	if got := c.Stats(); got != (CacheStats{}) {
+		t.Errorf("initial Stats = %+v, want zero", got)
+	}
+
+	c.recordLookup(false)
+	if err := c.Set("a", []byte("12345"), `"a"`); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+	if err := c.Set("b", []byte("123"), `"b"`); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+	c.recordLookup(true)
+	_ = c.GetETag("a") // direct reads are not lookups
+	_ = c.GetBody("a")
+
+	got := c.Stats()
+	want := CacheStats{Hits: 1, Misses: 1, Stores: 2, SizeBytes: 8}
+	if got != want {
+		t.Errorf("Stats = %+v, want %+v", got, want)
+	}
+
+	if err := c.Clear(); err != nil {
+		t.Fatalf("Clear: %v", err)
+	}
+	c.recordLookup(false)
+
+	got = c.Stats()
+	want = CacheStats{Hits: 1, Misses: 2, Stores: 2, Evictions: 2}
+	if got != want {
+		t.Errorf("Stats after Clear = %+v, want %+v", got, want)
+	}
+
+	c.ResetStats()
+	if got := c.Stats(); got != (CacheStats{}) {
+		t.Errorf("Stats after ResetStats = %+v, want zero", got)
+	}
+}
+
+func TestCache_Stats_Invalidate(t *testing.T) {
+	t.Parallel()
+	c := NewCache(t.TempDir())
+
+	_ = c.Set("a", []byte("body"), `"a"`)
+	_ = c.Invalidate("a")
+	_ = c.Invalidate("a") // already gone, not counted again
+
+	if got := c.Stats().Evictions; got != 1 {
+		t.Errorf("Evictions = %d, want 1", got)
+	}
+}
+
+func TestCache_Stats_SizeBytes(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	c := NewCache(dir)
+
+	_ = c.Set("a", []byte("12345"), `"a"`)
+	_ = c.Set("b", []byte("123"), `"b"`)
+	_ = c.Set("a", []byte("12"), `"a2"`) // replacing an entry counts its new size only
+	if got := c.Stats().SizeBytes; got != 5 {
+		t.Errorf("SizeBytes = %d, want 5", got)
+	}
+
+	_ = c.Invalidate("b")
+	if got := c.Stats().SizeBytes; got != 2 {
+		t.Errorf("SizeBytes after Invalidate = %d, want 2", got)
+	}
+
+	// A new Cache over the same directory measures what is already on disk.
+	reopened := NewCache(dir)
+	if got := reopened.Stats().SizeBytes; got != 2 {
+		t.Errorf("reopened SizeBytes = %d, want 2", got)
+	}
+}
+
+func TestClient_CacheStatsCountOneLookupPerGet(t *testing.T) {
+	etag := `"v1"`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", etag)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":1}`))
+	}))
+	defer server.Close()
+
+	cache := NewCache(t.TempDir())
+	client := NewClient(&Config{BaseURL: server.URL}, &StaticTokenProvider{Token: "test-token"}, WithCache(cache))
+	ctx := context.Background()
+
+	// Miss (nothing cached), hit (304), then miss (stale ETag, 200).
+	for i, serverETag := range []string{`"v1"`, `"v1"`, `"v2"`} {
+		etag = serverETag
+		if _, err := client.Get(ctx, "/todos/1.json"); err != nil {
+			t.Fatalf("Get %d: %v", i+1, err)
+		}
+	}
+
+	if got := cache.Stats(); got.Hits != 1 || got.Misses != 2 {
+		t.Errorf("Stats = %+v, want 1 hit and 2 misses", got)
+	}
+}
+
+func TestCache_Stats_Concurrent(t *testing.T) {
+	t.Parallel()
+	c := NewCache(t.TempDir())
+	if err := c.Set("hit", []byte("body"), `"e"`); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+	c.ResetStats()
+
+	var wg sync.WaitGroup
+	for range 50 {
+		wg.Go(func() { c.recordLookup(true) })
+		wg.Go(func() { c.recordLookup(false) })
+		wg.Go(func() { _ = c.Set("hit", []byte("body"), `"e"`) })
+	}
+	wg.Wait()
+
+	got := c.Stats()
+	if got.Hits != 50 || got.Misses != 50 || got.Stores != 50 || got.SizeBytes != 4 {
+		t.Errorf("Stats = %+v, want 50 hits, 50 misses, 50 stores, 4 bytes", got)
+	}
+}
+
+func TestCache_URLForKey(t *testing.T) {
+	c := NewCache(t.TempDir())
+	url := "https://example.com/99999/todos/1.json"
+	key := c.Key(url, "", "Bearer t")
+	_ = c.setURL(key, url, "Bearer t", []byte("x"), `"e"`)
+	_ = c.Set("unindexed", []byte("raw"), `"e"`)
+
+	if got := c.URLForKey(key); got != url {
+		t.Errorf("URLForKey = %q, want %q", got, url)
+	}
+	if got := c.URLForKey("unindexed"); got != "" {
+		t.Errorf("URLForKey unindexed = %q, want empty", got)
+	}
+
+	_ = c.Clear()
+	if got := c.URLForKey(key); got != "" {
+		t.Errorf("URLForKey after Clear = %q, want empty", got)
+	}
+}
+
+func TestClient_CacheFilesDoNotContainToken(t *testing.T) {
+	const token = "secret-token-abcdef123456"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", `"v1"`)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":1}`))
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	client := NewClient(&Config{BaseURL: server.URL}, &StaticTokenProvider{Token: token}, WithCache(NewCache(dir)))
+	if _, err := client.Get(context.Background(), "/todos/1.json"); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+
+	var files int
+	err := filepath.WalkDir(dir, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		files++
+		name := d.Name()
+		for i := 0; i+8 <= len(token); i++ {
+			if strings.Contains(name, token[i:i+8]) {
+				t.Errorf("cache file %q contains token substring %q", path, token[i:i+8])
+				break
+			}
+		}
+		if d.IsDir() {
+			return nil
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		if strings.Contains(string(data), token) {
+			t.Errorf("cache file %q contains the token", path)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("WalkDir: %v", err)
+	}
+	if files < 3 {
+		t.Fatalf("expected cache files to be written, found %d entries", files)
+	}
+}
@@ -204,6 +204,7 @@ func (s *CampfiresService) List(ctx context.Context, opts *CampfireListOptions)
 	op := OperationInfo{
 		Service: "Campfires", Operation: "List",
 		ResourceType: "campfire", IsMutation: false,
+		AccountID: s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -224,6 +225,7 @@ func (s *CampfiresService) List(ctx context.Context, opts *CampfireListOptions)
 
 	// Capture total count from X-Total-Count header
 	totalCount := parseTotalCount(resp.HTTPResponse)
+	totalPages := parseTotalPages(resp.HTTPResponse, firstPageLen(resp.JSON200))
 
 	// Parse first page
 	var campfires []Campfire
@@ -235,7 +237,7 @@ func (s *CampfiresService) List(ctx context.Context, opts *CampfireListOptions)
 
 	// Handle single page fetch (--page flag)
 	if opts != nil && opts.Page > 0 {
-		return &CampfireListResult{Campfires: campfires, Meta: ListMeta{TotalCount: totalCount}}, nil
+		return &CampfireListResult{Campfires: campfires, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages}}, nil
 	}
 
 	// Determine limit: 0 = all (no limit)
@@ -250,7 +252,7 @@ func (s *CampfiresService) List(ctx context.Context, opts *CampfireListOptions)
 
 	// Check if we already have enough items
 	if limit > 0 && len(campfires) >= limit {
-		return &CampfireListResult{Campfires: campfires[:limit], Meta: ListMeta{TotalCount: totalCount, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(campfires), limit)}}, nil
+		return &CampfireListResult{Campfires: campfires[:limit], Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(campfires), limit)}}, nil
 	}
 
 	// Follow pagination via Link headers
@@ -268,7 +270,7 @@ func (s *CampfiresService) List(ctx context.Context, opts *CampfireListOptions)
 		campfires = append(campfires, campfireFromGenerated(gc))
 	}
 
-	return &CampfireListResult{Campfires: campfires, Meta: ListMeta{TotalCount: totalCount, Truncated: truncated}}, nil
+	return &CampfireListResult{Campfires: campfires, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: truncated}}, nil
 }
 
 // Get returns a campfire by ID.
@@ -277,6 +279,7 @@ func (s *CampfiresService) Get(ctx context.Context, campfireID int64) (result *C
 		Service: "Campfires", Operation: "Get",
 		ResourceType: "campfire", IsMutation: false,
 		ResourceID: campfireID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -303,6 +306,99 @@ func (s *CampfiresService) Get(ctx context.Context, campfireID int64) (result *C
 	return &campfire, nil
 }
 
+// GetSubscription returns the current user's subscription to the campfire.
+// Subscribed is false when the user will not be notified of new lines.
+func (s *CampfiresService) GetSubscription(ctx context.Context, campfireID int64) (result *Subscription, err error) {
+	op := OperationInfo{
+		Service: "Campfires", Operation: "GetSubscription",
+		ResourceType: "campfire", IsMutation: false,
+		ResourceID: campfireID,
+		AccountID:  s.client.accountID,
+	}
+	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
+		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
+			return
+		}
+	}
+	start := time.Now()
+	ctx = s.client.parent.hooks.OnOperationStart(ctx, op)
+	defer func() { s.client.parent.hooks.OnOperationEnd(ctx, op, err, time.Since(start)) }()
+
+	resp, err := s.client.parent.gen.GetSubscriptionWithResponse(ctx, s.client.accountID, campfireID)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkResponse(resp.HTTPResponse, resp.Body); err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		err = fmt.Errorf("unexpected empty response")
+		return nil, err
+	}
+
+	sub := subscriptionFromGenerated(*resp.JSON200)
+	return &sub, nil
+}
+
+// Subscribe subscribes the current user to the campfire.
+// Returns the updated subscription information.
+func (s *CampfiresService) Subscribe(ctx context.Context, campfireID int64) (result *Subscription, err error) {
+	op := OperationInfo{
+		Service: "Campfires", Operation: "Subscribe",
+		ResourceType: "campfire", IsMutation: true,
+		ResourceID: campfireID,
+		AccountID:  s.client.accountID,
+	}
+	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
+		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
+			return
+		}
+	}
+	start := time.Now()
+	ctx = s.client.parent.hooks.OnOperationStart(ctx, op)
+	defer func() { s.client.parent.hooks.OnOperationEnd(ctx, op, err, time.Since(start)) }()
+
+	resp, err := s.client.parent.gen.SubscribeWithResponse(ctx, s.client.accountID, campfireID)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkResponse(resp.HTTPResponse, resp.Body); err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		err = fmt.Errorf("unexpected empty response")
+		return nil, err
+	}
+
+	sub := subscriptionFromGenerated(*resp.JSON200)
+	return &sub, nil
+}
+
+// Unsubscribe unsubscribes the current user from the campfire.
+// Returns nil on success (204 No Content).
+func (s *CampfiresService) Unsubscribe(ctx context.Context, campfireID int64) (err error) {
+	op := OperationInfo{
+		Service: "Campfires", Operation: "Unsubscribe",
+		ResourceType: "campfire", IsMutation: true,
+		ResourceID: campfireID,
+		AccountID:  s.client.accountID,
+	}
+	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
+		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
+			return
+		}
+	}
+	start := time.Now()
+	ctx = s.client.parent.hooks.OnOperationStart(ctx, op)
+	defer func() { s.client.parent.hooks.OnOperationEnd(ctx, op, err, time.Since(start)) }()
+
+	resp, err := s.client.parent.gen.UnsubscribeWithResponse(ctx, s.client.accountID, campfireID)
+	if err != nil {
+		return err
+	}
+	return checkResponse(resp.HTTPResponse, resp.Body)
+}
+
 // ListLines returns all lines (messages) in a campfire.
 //
 // By default, returns up to 100 lines. Use Limit: -1 for unlimited.
@@ -318,6 +414,7 @@ func (s *CampfiresService) ListLines(ctx context.Context, campfireID int64, opts
 		Service: "Campfires", Operation: "ListLines",
 		ResourceType: "campfire_line", IsMutation: false,
 		ResourceID: campfireID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -346,6 +443,7 @@ func (s *CampfiresService) ListLines(ctx context.Context, campfireID int64, opts
 
 	// Capture total count from X-Total-Count header
 	totalCount := parseTotalCount(resp.HTTPResponse)
+	totalPages := parseTotalPages(resp.HTTPResponse, firstPageLen(resp.JSON200))
 
 	// Parse first page
 	var lines []CampfireLine
@@ -357,7 +455,7 @@ func (s *CampfiresService) ListLines(ctx context.Context, campfireID int64, opts
 
 	// Handle single page fetch (--page flag)
 	if opts != nil && opts.Page > 0 {
-		return &CampfireLineListResult{Lines: lines, Meta: ListMeta{TotalCount: totalCount}}, nil
+		return &CampfireLineListResult{Lines: lines, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages}}, nil
 	}
 
 	// Determine limit: 0 = default (100), -1 = unlimited, >0 = specific limit
@@ -372,7 +470,7 @@ func (s *CampfiresService) ListLines(ctx context.Context, campfireID int64, opts
 
 	// Check if we already have enough items
 	if limit > 0 && len(lines) >= limit {
-		return &CampfireLineListResult{Lines: lines[:limit], Meta: ListMeta{TotalCount: totalCount, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(lines), limit)}}, nil
+		return &CampfireLineListResult{Lines: lines[:limit], Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(lines), limit)}}, nil
 	}
 
 	// Follow pagination via Link headers
@@ -390,7 +488,7 @@ func (s *CampfiresService) ListLines(ctx context.Context, campfireID int64, opts
 		lines = append(lines, campfireLineFromGenerated(gl))
 	}
 
-	return &CampfireLineListResult{Lines: lines, Meta: ListMeta{TotalCount: totalCount, Truncated: truncated}}, nil
+	return &CampfireLineListResult{Lines: lines, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: truncated}}, nil
 }
 
 // GetLine returns a single line (message) from a campfire.
@@ -399,6 +497,7 @@ func (s *CampfiresService) GetLine(ctx context.Context, campfireID, lineID int64
 		Service: "Campfires", Operation: "GetLine",
 		ResourceType: "campfire_line", IsMutation: false,
 		ResourceID: lineID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -433,6 +532,7 @@ func (s *CampfiresService) CreateLine(ctx context.Context, campfireID int64, con
 		Service: "Campfires", Operation: "CreateLine",
 		ResourceType: "campfire_line", IsMutation: true,
 		ResourceID: campfireID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -494,6 +594,7 @@ func (s *CampfiresService) UpdateLine(ctx context.Context, campfireID, lineID in
 		Service: "Campfires", Operation: "UpdateLine",
 		ResourceType: "campfire_line", IsMutation: true,
 		ResourceID: lineID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -526,6 +627,7 @@ func (s *CampfiresService) DeleteLine(ctx context.Context, campfireID, lineID in
 		Service: "Campfires", Operation: "DeleteLine",
 		ResourceType: "campfire_line", IsMutation: true,
 		ResourceID: lineID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -558,6 +660,7 @@ func (s *CampfiresService) ListUploads(ctx context.Context, campfireID int64, op
 		Service: "Campfires", Operation: "ListUploads",
 		ResourceType: "campfire_line", IsMutation: false,
 		ResourceID: campfireID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -585,6 +688,7 @@ func (s *CampfiresService) ListUploads(ctx context.Context, campfireID int64, op
 	}
 
 	totalCount := parseTotalCount(resp.HTTPResponse)
+	totalPages := parseTotalPages(resp.HTTPResponse, firstPageLen(resp.JSON200))
 
 	// Parse first page
 	var lines []CampfireLine
@@ -596,7 +700,7 @@ func (s *CampfiresService) ListUploads(ctx context.Context, campfireID int64, op
 
 	// Handle single page fetch (--page flag)
 	if opts != nil && opts.Page > 0 {
-		return &CampfireLineListResult{Lines: lines, Meta: ListMeta{TotalCount: totalCount}}, nil
+		return &CampfireLineListResult{Lines: lines, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages}}, nil
 	}
 
 	// Determine limit: 0 = default (100), -1 = unlimited, >0 = specific limit
@@ -611,7 +715,7 @@ func (s *CampfiresService) ListUploads(ctx context.Context, campfireID int64, op
 
 	// Check if we already have enough items
 	if limit > 0 && len(lines) >= limit {
-		return &CampfireLineListResult{Lines: lines[:limit], Meta: ListMeta{TotalCount: totalCount, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(lines), limit)}}, nil
+		return &CampfireLineListResult{Lines: lines[:limit], Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(lines), limit)}}, nil
 	}
 
 	// Follow pagination via Link headers
@@ -629,7 +733,7 @@ func (s *CampfiresService) ListUploads(ctx context.Context, campfireID int64, op
 		lines = append(lines, campfireLineFromGenerated(gl))
 	}
 
-	return &CampfireLineListResult{Lines: lines, Meta: ListMeta{TotalCount: totalCount, Truncated: truncated}}, nil
+	return &CampfireLineListResult{Lines: lines, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: truncated}}, nil
 }
 
 // CreateUpload uploads a file to a campfire.
@@ -640,6 +744,7 @@ func (s *CampfiresService) CreateUpload(ctx context.Context, campfireID int64, f
 		Service: "Campfires", Operation: "CreateUpload",
 		ResourceType: "campfire_line", IsMutation: true,
 		ResourceID: campfireID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -727,6 +832,7 @@ func (s *CampfiresService) ListChatbots(ctx context.Context, campfireID int64, o
 		Service: "Campfires", Operation: "ListChatbots",
 		ResourceType: "chatbot", IsMutation: false,
 		ResourceID: campfireID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -748,6 +854,7 @@ func (s *CampfiresService) ListChatbots(ctx context.Context, campfireID int64, o
 
 	// Capture total count from X-Total-Count header (first page only)
 	totalCount := parseTotalCount(resp.HTTPResponse)
+	totalPages := parseTotalPages(resp.HTTPResponse, firstPageLen(resp.JSON200))
 
 	// Parse first page
 	var chatbots []Chatbot
@@ -759,7 +866,7 @@ func (s *CampfiresService) ListChatbots(ctx context.Context, campfireID int64, o
 
 	// Handle single page fetch (--page flag)
 	if opts != nil && opts.Page > 0 {
-		return &ChatbotListResult{Chatbots: chatbots, Meta: ListMeta{TotalCount: totalCount}}, nil
+		return &ChatbotListResult{Chatbots: chatbots, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages}}, nil
 	}
 
 	// Determine limit: 0 = all (default for chatbots)
@@ -774,7 +881,7 @@ func (s *CampfiresService) ListChatbots(ctx context.Context, campfireID int64, o
 
 	// Check if we already have enough items
 	if limit > 0 && len(chatbots) >= limit {
-		return &ChatbotListResult{Chatbots: chatbots[:limit], Meta: ListMeta{TotalCount: totalCount, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(chatbots), limit)}}, nil
+		return &ChatbotListResult{Chatbots: chatbots[:limit], Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(chatbots), limit)}}, nil
 	}
 
 	// Follow pagination via Link headers (uses absolute URLs from API, no path construction)
@@ -792,7 +899,7 @@ func (s *CampfiresService) ListChatbots(ctx context.Context, campfireID int64, o
 		chatbots = append(chatbots, chatbotFromGenerated(gc))
 	}
 
-	return &ChatbotListResult{Chatbots: chatbots, Meta: ListMeta{TotalCount: totalCount, Truncated: truncated}}, nil
+	return &ChatbotListResult{Chatbots: chatbots, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: truncated}}, nil
 }
 
 // GetChatbot returns a chatbot by ID.
@@ -801,6 +908,7 @@ func (s *CampfiresService) GetChatbot(ctx context.Context, campfireID, chatbotID
 		Service: "Campfires", Operation: "GetChatbot",
 		ResourceType: "chatbot", IsMutation: false,
 		ResourceID: chatbotID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -835,6 +943,7 @@ func (s *CampfiresService) CreateChatbot(ctx context.Context, campfireID int64,
 		Service: "Campfires", Operation: "CreateChatbot",
 		ResourceType: "chatbot", IsMutation: true,
 		ResourceID: campfireID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -881,6 +990,7 @@ func (s *CampfiresService) UpdateChatbot(ctx context.Context, campfireID, chatbo
 		Service: "Campfires", Operation: "UpdateChatbot",
 		ResourceType: "chatbot", IsMutation: true,
 		ResourceID: chatbotID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -926,6 +1036,7 @@ func (s *CampfiresService) DeleteChatbot(ctx context.Context, campfireID, chatbo
 		Service: "Campfires", Operation: "DeleteChatbot",
 		ResourceType: "chatbot", IsMutation: true,
 		ResourceID: chatbotID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
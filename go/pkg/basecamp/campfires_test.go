@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -911,6 +912,69 @@ func TestListLines_SortDirection(t *testing.T) {
 	}
 }
 
+func TestListLines_FollowsPagination(t *testing.T) {
+	fixture := loadCampfiresFixture(t, "lines_list.json")
+	page2Fixture := `[{"id":1069479399,"status":"active","type":"Chat::Lines::Text","title":"Page two","content":"Page two","created_at":"2022-11-22T09:00:00.000Z","updated_at":"2022-11-22T09:00:00.000Z"}]`
+
+	var requestCount int
+	svc := testCampfiresServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requestCount++
+		if r.URL.Path != "/99999/chats/100/lines.json" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if requestCount == 1 {
+			w.Header().Set("Link", fmt.Sprintf(`<http://%s/99999/chats/100/lines.json?page=2>; rel="next"`, r.Host))
+			w.WriteHeader(200)
+			w.Write(fixture)
+			return
+		}
+		if r.URL.Query().Get("page") != "2" {
+			t.Errorf("expected page=2 on second request, got %q", r.URL.Query().Get("page"))
+		}
+		w.WriteHeader(200)
+		w.Write([]byte(page2Fixture))
+	})
+
+	result, err := svc.ListLines(context.Background(), 100, &CampfireLineListOptions{Limit: -1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if requestCount != 2 {
+		t.Errorf("expected 2 HTTP requests (2 pages), got %d", requestCount)
+	}
+	if len(result.Lines) < 2 {
+		t.Fatalf("expected lines from both pages, got %d", len(result.Lines))
+	}
+	if last := result.Lines[len(result.Lines)-1]; last.ID != 1069479399 {
+		t.Errorf("expected last line ID 1069479399, got %d", last.ID)
+	}
+}
+
+func TestListLines_ContextCanceled(t *testing.T) {
+	var requestCount int
+	svc := testCampfiresServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requestCount++
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		w.Write([]byte("[]"))
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := svc.ListLines(ctx, 100, nil)
+	if err == nil {
+		t.Fatal("expected error for canceled context")
+	}
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+	if requestCount != 0 {
+		t.Errorf("expected no HTTP requests after cancellation, got %d", requestCount)
+	}
+}
+
 func TestListUploads_SortDirection(t *testing.T) {
 	fixture := loadCampfiresFixture(t, "uploads_list.json")
 	svc := testCampfiresServer(t, func(w http.ResponseWriter, r *http.Request) {
@@ -1057,3 +1121,64 @@ func TestUpdateChatbotRequest_Marshal(t *testing.T) {
 		t.Errorf("unexpected command_url: %v", data["command_url"])
 	}
 }
+
+func TestCampfiresService_SubscribeAndUnsubscribe(t *testing.T) {
+	var requests []string
+	svc := testCampfiresServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		switch r.Method {
+		case "POST":
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `{"subscribed":true,"count":1,"url":"https://3.basecampapi.com/99999/recordings/200/subscription.json","subscribers":[{"id":1,"name":"Victor Cooper"}]}`)
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+
+	sub, err := svc.Subscribe(context.Background(), 200)
+	if err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+	if !sub.Subscribed || sub.Count != 1 || len(sub.Subscribers) != 1 {
+		t.Errorf("unexpected subscription: %+v", sub)
+	}
+	if err := svc.Unsubscribe(context.Background(), 200); err != nil {
+		t.Fatalf("Unsubscribe: %v", err)
+	}
+
+	want := []string{"POST /99999/recordings/200/subscription.json", "DELETE /99999/recordings/200/subscription.json"}
+	if len(requests) != len(want) {
+		t.Fatalf("requests = %v, want %v", requests, want)
+	}
+	for i := range want {
+		if requests[i] != want[i] {
+			t.Errorf("request %d = %q, want %q", i, requests[i], want[i])
+		}
+	}
+}
+
+func TestCampfiresService_GetSubscription_NotSubscribed(t *testing.T) {
+	svc := testCampfiresServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			t.Errorf("expected GET, got %s", r.Method)
+		}
+		if r.URL.Path != "/99999/recordings/200/subscription.json" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"subscribed":false,"count":0,"url":"https://3.basecampapi.com/99999/recordings/200/subscription.json","subscribers":[]}`)
+	})
+
+	sub, err := svc.GetSubscription(context.Background(), 200)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sub.Subscribed {
+		t.Error("expected Subscribed to be false")
+	}
+	if sub.Count != 0 {
+		t.Errorf("expected Count 0, got %d", sub.Count)
+	}
+}
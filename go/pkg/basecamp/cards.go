@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"time"
 
+	"golang.org/x/sync/errgroup"
+
 	"github.com/basecamp/basecamp-sdk/go/pkg/generated"
 	"github.com/basecamp/basecamp-sdk/go/pkg/types"
 )
@@ -171,6 +173,17 @@ type CardListOptions struct {
 	Page int
 }
 
+// DefaultAllCardsConcurrency is the number of columns AllCards fetches at once
+// when no concurrency is specified.
+const DefaultAllCardsConcurrency = 5
+
+// CardTableAllCardsOptions specifies options for CardTablesService.AllCards.
+type CardTableAllCardsOptions struct {
+	// Concurrency is the maximum number of columns fetched at once.
+	// If 0 (default), DefaultAllCardsConcurrency is used.
+	Concurrency int
+}
+
 // CardListResult contains the results from listing cards.
 type CardListResult struct {
 	// Cards is the list of cards returned.
@@ -248,6 +261,7 @@ func (s *CardTablesService) Get(ctx context.Context, cardTableID int64) (result
 		Service: "CardTables", Operation: "Get",
 		ResourceType: "card_table", IsMutation: false,
 		ResourceID: cardTableID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -274,6 +288,50 @@ func (s *CardTablesService) Get(ctx context.Context, cardTableID int64) (result
 	return &cardTable, nil
 }
 
+// AllCards returns every card on a card table, in column order.
+//
+// It reads the table's columns with Get, then lists each column's cards with
+// Cards().List, fetching up to opts.Concurrency columns at once. The first
+// error cancels the remaining fetches and is returned with the failing column
+// ID; no partial results are returned. Hooks observe the CardTables.Get and
+// Cards.List operations.
+func (s *CardTablesService) AllCards(ctx context.Context, cardTableID int64, opts *CardTableAllCardsOptions) ([]Card, error) {
+	table, err := s.Get(ctx, cardTableID)
+	if err != nil {
+		return nil, err
+	}
+
+	concurrency := DefaultAllCardsConcurrency
+	if opts != nil && opts.Concurrency > 0 {
+		concurrency = opts.Concurrency
+	}
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(concurrency)
+
+	cards := s.client.Cards()
+	columns := make([][]Card, len(table.Lists))
+	for i, column := range table.Lists {
+		g.Go(func() error {
+			result, err := cards.List(gctx, column.ID, nil)
+			if err != nil {
+				return fmt.Errorf("column %d: %w", column.ID, err)
+			}
+			columns[i] = result.Cards
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	var all []Card
+	for _, c := range columns {
+		all = append(all, c...)
+	}
+	return all, nil
+}
+
 // CardsService handles card operations.
 type CardsService struct {
 	client *AccountClient
@@ -299,6 +357,7 @@ func (s *CardsService) List(ctx context.Context, columnID int64, opts *CardListO
 		Service: "Cards", Operation: "List",
 		ResourceType: "card", IsMutation: false,
 		ResourceID: columnID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -320,6 +379,7 @@ func (s *CardsService) List(ctx context.Context, columnID int64, opts *CardListO
 
 	// Capture total count from X-Total-Count header (first page only)
 	totalCount := parseTotalCount(resp.HTTPResponse)
+	totalPages := parseTotalPages(resp.HTTPResponse, firstPageLen(resp.JSON200))
 
 	// Parse first page
 	var cards []Card
@@ -331,7 +391,7 @@ func (s *CardsService) List(ctx context.Context, columnID int64, opts *CardListO
 
 	// Handle single page fetch (--page flag)
 	if opts != nil && opts.Page > 0 {
-		return &CardListResult{Cards: cards, Meta: ListMeta{TotalCount: totalCount}}, nil
+		return &CardListResult{Cards: cards, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages}}, nil
 	}
 
 	// Determine limit: 0 = all (default for cards), >0 = specific limit
@@ -342,7 +402,7 @@ func (s *CardsService) List(ctx context.Context, columnID int64, opts *CardListO
 
 	// Check if we already have enough items
 	if limit > 0 && len(cards) >= limit {
-		return &CardListResult{Cards: cards[:limit], Meta: ListMeta{TotalCount: totalCount, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(cards), limit)}}, nil
+		return &CardListResult{Cards: cards[:limit], Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(cards), limit)}}, nil
 	}
 
 	// Follow pagination via Link headers (uses absolute URLs from API, no path construction)
@@ -360,7 +420,7 @@ func (s *CardsService) List(ctx context.Context, columnID int64, opts *CardListO
 		cards = append(cards, cardFromGenerated(gc))
 	}
 
-	return &CardListResult{Cards: cards, Meta: ListMeta{TotalCount: totalCount, Truncated: truncated}}, nil
+	return &CardListResult{Cards: cards, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: truncated}}, nil
 }
 
 // Get returns a card by ID.
@@ -369,6 +429,7 @@ func (s *CardsService) Get(ctx context.Context, cardID int64) (result *Card, err
 		Service: "Cards", Operation: "Get",
 		ResourceType: "card", IsMutation: false,
 		ResourceID: cardID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -402,6 +463,7 @@ func (s *CardsService) Create(ctx context.Context, columnID int64, req *CreateCa
 		Service: "Cards", Operation: "Create",
 		ResourceType: "card", IsMutation: true,
 		ResourceID: columnID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -454,11 +516,60 @@ func (s *CardsService) Create(ctx context.Context, columnID int64, req *CreateCa
 
 // Update updates an existing card.
 // Returns the updated card.
-func (s *CardsService) Update(ctx context.Context, cardID int64, req *UpdateCardRequest) (result *Card, err error) {
+func (s *CardsService) Update(ctx context.Context, cardID int64, req *UpdateCardRequest) (*Card, error) {
+	return s.updateCard(ctx, cardID, func() (map[string]any, error) {
+		if req == nil {
+			return nil, ErrUsage("update request is required")
+		}
+
+		body := map[string]any{}
+		if req.Title != "" {
+			body["title"] = req.Title
+		}
+		if req.Content != "" {
+			body["content"] = req.Content
+		}
+		if req.DueOn != "" {
+			if _, parseErr := types.ParseDate(req.DueOn); parseErr != nil {
+				return nil, ErrUsage("card due_on must be in YYYY-MM-DD format")
+			}
+			body["due_on"] = req.DueOn
+		}
+		if req.AssigneeIDs != nil {
+			body["assignee_ids"] = req.AssigneeIDs
+		}
+		return body, nil
+	})
+}
+
+// SetDueDate sets a card's due date (YYYY-MM-DD). Only due_on is sent, so
+// the card's other attributes are left untouched.
+func (s *CardsService) SetDueDate(ctx context.Context, cardID int64, dueOn string) error {
+	if dueOn == "" {
+		return ErrUsage("due date is required (use ClearDueDate to remove it)")
+	}
+	_, err := s.Update(ctx, cardID, &UpdateCardRequest{DueOn: dueOn})
+	return err
+}
+
+// ClearDueDate removes a card's due date by sending "due_on": null. The
+// card's other attributes are left untouched.
+func (s *CardsService) ClearDueDate(ctx context.Context, cardID int64) error {
+	_, err := s.updateCard(ctx, cardID, func() (map[string]any, error) {
+		return map[string]any{"due_on": nil}, nil
+	})
+	return err
+}
+
+// updateCard sends the body built by buildBody as a partial card update.
+// Hooks observe it as Cards.Update. buildBody runs inside the operation so
+// its usage errors reach OnOperationEnd.
+func (s *CardsService) updateCard(ctx context.Context, cardID int64, buildBody func() (map[string]any, error)) (result *Card, err error) {
 	op := OperationInfo{
 		Service: "Cards", Operation: "Update",
 		ResourceType: "card", IsMutation: true,
 		ResourceID: cardID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -469,29 +580,11 @@ func (s *CardsService) Update(ctx context.Context, cardID int64, req *UpdateCard
 	ctx = s.client.parent.hooks.OnOperationStart(ctx, op)
 	defer func() { s.client.parent.hooks.OnOperationEnd(ctx, op, err, time.Since(start)) }()
 
-	if req == nil {
-		err = ErrUsage("update request is required")
+	body, err := buildBody()
+	if err != nil {
 		return nil, err
 	}
 
-	body := map[string]any{}
-	if req.Title != "" {
-		body["title"] = req.Title
-	}
-	if req.Content != "" {
-		body["content"] = req.Content
-	}
-	if req.DueOn != "" {
-		if _, parseErr := types.ParseDate(req.DueOn); parseErr != nil {
-			err = ErrUsage("card due_on must be in YYYY-MM-DD format")
-			return nil, err
-		}
-		body["due_on"] = req.DueOn
-	}
-	if req.AssigneeIDs != nil {
-		body["assignee_ids"] = req.AssigneeIDs
-	}
-
 	bodyReader, err := marshalBody(body)
 	if err != nil {
 		return nil, err
@@ -525,6 +618,7 @@ func (s *CardsService) Move(ctx context.Context, cardID, columnID int64, opts *M
 		Service: "Cards", Operation: "Move",
 		ResourceType: "card", IsMutation: true,
 		ResourceID: cardID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -556,6 +650,7 @@ func (s *CardsService) Trash(ctx context.Context, cardID int64) (err error) {
 		Service: "Cards", Operation: "Trash",
 		ResourceType: "card", IsMutation: true,
 		ResourceID: cardID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -589,6 +684,7 @@ func (s *CardColumnsService) Get(ctx context.Context, columnID int64) (result *C
 		Service: "CardColumns", Operation: "Get",
 		ResourceType: "card_column", IsMutation: false,
 		ResourceID: columnID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -622,6 +718,7 @@ func (s *CardColumnsService) Create(ctx context.Context, cardTableID int64, req
 		Service: "CardColumns", Operation: "Create",
 		ResourceType: "card_column", IsMutation: true,
 		ResourceID: cardTableID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -666,6 +763,7 @@ func (s *CardColumnsService) Update(ctx context.Context, columnID int64, req *Up
 		Service: "CardColumns", Operation: "Update",
 		ResourceType: "card_column", IsMutation: true,
 		ResourceID: columnID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -711,6 +809,7 @@ func (s *CardColumnsService) Move(ctx context.Context, cardTableID int64, req *M
 		Service: "CardColumns", Operation: "Move",
 		ResourceType: "card_column", IsMutation: true,
 		ResourceID: cardTableID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -747,6 +846,7 @@ func (s *CardColumnsService) SetColor(ctx context.Context, bucketID, columnID in
 		Service: "CardColumns", Operation: "SetColor",
 		ResourceType: "card_column", IsMutation: true,
 		ResourceID: columnID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -789,6 +889,7 @@ func (s *CardColumnsService) EnableOnHold(ctx context.Context, bucketID, columnI
 		Service: "CardColumns", Operation: "EnableOnHold",
 		ResourceType: "card_column", IsMutation: true,
 		ResourceID: columnID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -822,6 +923,7 @@ func (s *CardColumnsService) DisableOnHold(ctx context.Context, bucketID, column
 		Service: "CardColumns", Operation: "DisableOnHold",
 		ResourceType: "card_column", IsMutation: true,
 		ResourceID: columnID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -855,6 +957,7 @@ func (s *CardColumnsService) Watch(ctx context.Context, columnID int64) (result
 		Service: "CardColumns", Operation: "Watch",
 		ResourceType: "card_column", IsMutation: true,
 		ResourceID: columnID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -888,6 +991,7 @@ func (s *CardColumnsService) Unwatch(ctx context.Context, columnID int64) (err e
 		Service: "CardColumns", Operation: "Unwatch",
 		ResourceType: "card_column", IsMutation: true,
 		ResourceID: columnID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -921,6 +1025,7 @@ func (s *CardStepsService) Get(ctx context.Context, stepID int64) (result *CardS
 		Service: "CardSteps", Operation: "Get",
 		ResourceType: "card_step", IsMutation: false,
 		ResourceID: stepID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -954,6 +1059,7 @@ func (s *CardStepsService) Create(ctx context.Context, cardID int64, req *Create
 		Service: "CardSteps", Operation: "Create",
 		ResourceType: "card_step", IsMutation: true,
 		ResourceID: cardID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -1006,6 +1112,7 @@ func (s *CardStepsService) Update(ctx context.Context, stepID int64, req *Update
 		Service: "CardSteps", Operation: "Update",
 		ResourceType: "card_step", IsMutation: true,
 		ResourceID: stepID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -1063,6 +1170,7 @@ func (s *CardStepsService) Complete(ctx context.Context, stepID int64) (result *
 		Service: "CardSteps", Operation: "Complete",
 		ResourceType: "card_step", IsMutation: true,
 		ResourceID: stepID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -1097,6 +1205,7 @@ func (s *CardStepsService) Uncomplete(ctx context.Context, stepID int64) (result
 		Service: "CardSteps", Operation: "Uncomplete",
 		ResourceType: "card_step", IsMutation: true,
 		ResourceID: stepID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -1131,6 +1240,7 @@ func (s *CardStepsService) Reposition(ctx context.Context, cardID, stepID int64,
 		Service: "CardSteps", Operation: "Reposition",
 		ResourceType: "card_step", IsMutation: true,
 		ResourceID: stepID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -1164,6 +1274,7 @@ func (s *CardStepsService) Delete(ctx context.Context, stepID int64) (err error)
 		Service: "CardSteps", Operation: "Delete",
 		ResourceType: "card_step", IsMutation: true,
 		ResourceID: stepID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -4,10 +4,13 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
 )
 
@@ -1002,3 +1005,144 @@ func TestCardColumnsService_DisableOnHold(t *testing.T) {
 		t.Fatalf("expected column ID %d, got %+v", cardColumnsTestColumnID, column)
 	}
 }
+
+func TestCardsService_SetDueDate(t *testing.T) {
+	fixture := loadCardsFixture(t, "get.json")
+	var receivedMethod, receivedPath string
+	var receivedBody map[string]any
+	svc := testCardsServer(t, func(w http.ResponseWriter, r *http.Request) {
+		receivedMethod = r.Method
+		receivedPath = r.URL.Path
+		receivedBody = decodeRequestBody(t, r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		w.Write(fixture)
+	})
+
+	if err := svc.SetDueDate(context.Background(), 12345, "2026-03-01"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if receivedMethod != "PUT" || receivedPath != "/99999/card_tables/cards/12345" {
+		t.Errorf("expected PUT /99999/card_tables/cards/12345, got %s %s", receivedMethod, receivedPath)
+	}
+	if len(receivedBody) != 1 || receivedBody["due_on"] != "2026-03-01" {
+		t.Errorf("expected body with only due_on, got %v", receivedBody)
+	}
+}
+
+func TestCardsService_ClearDueDate(t *testing.T) {
+	fixture := loadCardsFixture(t, "get.json")
+	var receivedBody map[string]any
+	svc := testCardsServer(t, func(w http.ResponseWriter, r *http.Request) {
+		receivedBody = decodeRequestBody(t, r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		w.Write(fixture)
+	})
+
+	if err := svc.ClearDueDate(context.Background(), 12345); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	dueOn, ok := receivedBody["due_on"]
+	if len(receivedBody) != 1 || !ok || dueOn != nil {
+		t.Errorf("expected body {\"due_on\": null}, got %v", receivedBody)
+	}
+}
+
+func TestCardsService_SetDueDate_InvalidFormat(t *testing.T) {
+	requests := 0
+	svc := testCardsServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	})
+
+	for _, dueOn := range []string{"", "03/01/2026", "2026-3-1"} {
+		if err := svc.SetDueDate(context.Background(), 12345, dueOn); ErrorCode(err) != CodeUsage {
+			t.Errorf("SetDueDate(%q) error = %v, want usage error", dueOn, err)
+		}
+	}
+	if requests != 0 {
+		t.Errorf("expected no requests, got %d", requests)
+	}
+}
+
+// testCardBoardServer serves card table 500 with four columns (1-4), each
+// holding two cards numbered column*10+1 and column*10+2. Requests for
+// failColumn's cards get a 403. Requested card list paths are recorded.
+func testCardBoardServer(t *testing.T, failColumn int64, requested *[]string) *CardTablesService {
+	t.Helper()
+	var mu sync.Mutex
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/99999/card_tables/500" {
+			fmt.Fprint(w, `{"id":500,"title":"Board","lists":[{"id":1},{"id":2},{"id":3},{"id":4}]}`)
+			return
+		}
+
+		var column int64
+		if _, err := fmt.Sscanf(r.URL.Path, "/99999/card_tables/lists/%d/cards.json", &column); err != nil {
+			t.Errorf("unexpected request: %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		mu.Lock()
+		*requested = append(*requested, r.URL.Path)
+		mu.Unlock()
+		if column == failColumn {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		fmt.Fprintf(w, `[{"id":%d,"title":"A"},{"id":%d,"title":"B"}]`, column*10+1, column*10+2)
+	}))
+	t.Cleanup(server.Close)
+
+	cfg := DefaultConfig()
+	cfg.BaseURL = server.URL
+	client := NewClient(cfg, &StaticTokenProvider{Token: "test-token"})
+	return client.ForAccount("99999").CardTables()
+}
+
+func TestCardTablesService_AllCards(t *testing.T) {
+	var requested []string
+	svc := testCardBoardServer(t, 0, &requested)
+
+	cards, err := svc.AllCards(context.Background(), 500, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(requested) != 4 {
+		t.Errorf("expected all 4 columns to be fetched, got %v", requested)
+	}
+	want := []int64{11, 12, 21, 22, 31, 32, 41, 42}
+	if len(cards) != len(want) {
+		t.Fatalf("expected %d cards, got %d", len(want), len(cards))
+	}
+	for i, id := range want {
+		if cards[i].ID != id {
+			t.Errorf("card %d ID = %d, want %d", i, cards[i].ID, id)
+		}
+	}
+}
+
+func TestCardTablesService_AllCards_ColumnError(t *testing.T) {
+	var requested []string
+	svc := testCardBoardServer(t, 2, &requested)
+
+	cards, err := svc.AllCards(context.Background(), 500, &CardTableAllCardsOptions{Concurrency: 1})
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if cards != nil {
+		t.Errorf("expected no cards on error, got %d", len(cards))
+	}
+	if !strings.Contains(err.Error(), "column 2") {
+		t.Errorf("expected error to name column 2, got %v", err)
+	}
+	// With one column at a time, the failure cancels columns 3 and 4
+	// before they are requested.
+	if len(requested) != 2 {
+		t.Errorf("expected only columns 1 and 2 to be requested, got %v", requested)
+	}
+}
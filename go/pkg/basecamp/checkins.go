@@ -186,6 +186,7 @@ func (s *CheckinsService) GetQuestionnaire(ctx context.Context, questionnaireID
 		Service: "Checkins", Operation: "GetQuestionnaire",
 		ResourceType: "questionnaire", IsMutation: false,
 		ResourceID: questionnaireID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -227,6 +228,7 @@ func (s *CheckinsService) ListQuestions(ctx context.Context, questionnaireID int
 		Service: "Checkins", Operation: "ListQuestions",
 		ResourceType: "question", IsMutation: false,
 		ResourceID: questionnaireID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -248,6 +250,7 @@ func (s *CheckinsService) ListQuestions(ctx context.Context, questionnaireID int
 
 	// Capture total count from X-Total-Count header
 	totalCount := parseTotalCount(resp.HTTPResponse)
+	totalPages := parseTotalPages(resp.HTTPResponse, firstPageLen(resp.JSON200))
 
 	// Parse first page
 	var questions []Question
@@ -259,7 +262,7 @@ func (s *CheckinsService) ListQuestions(ctx context.Context, questionnaireID int
 
 	// Handle single page fetch (--page flag)
 	if opts != nil && opts.Page > 0 {
-		return &QuestionListResult{Questions: questions, Meta: ListMeta{TotalCount: totalCount}}, nil
+		return &QuestionListResult{Questions: questions, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages}}, nil
 	}
 
 	// Determine limit: 0 = all (default for questions), >0 = specific limit
@@ -270,7 +273,7 @@ func (s *CheckinsService) ListQuestions(ctx context.Context, questionnaireID int
 
 	// Check if we already have enough items
 	if limit > 0 && len(questions) >= limit {
-		return &QuestionListResult{Questions: questions[:limit], Meta: ListMeta{TotalCount: totalCount, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(questions), limit)}}, nil
+		return &QuestionListResult{Questions: questions[:limit], Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(questions), limit)}}, nil
 	}
 
 	// Follow pagination via Link headers (uses absolute URLs from API, no path construction)
@@ -288,7 +291,7 @@ func (s *CheckinsService) ListQuestions(ctx context.Context, questionnaireID int
 		questions = append(questions, questionFromGenerated(gq))
 	}
 
-	return &QuestionListResult{Questions: questions, Meta: ListMeta{TotalCount: totalCount, Truncated: truncated}}, nil
+	return &QuestionListResult{Questions: questions, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: truncated}}, nil
 }
 
 // GetQuestion returns a question by ID.
@@ -297,6 +300,7 @@ func (s *CheckinsService) GetQuestion(ctx context.Context, questionID int64) (re
 		Service: "Checkins", Operation: "GetQuestion",
 		ResourceType: "question", IsMutation: false,
 		ResourceID: questionID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -330,6 +334,7 @@ func (s *CheckinsService) CreateQuestion(ctx context.Context, questionnaireID in
 		Service: "Checkins", Operation: "CreateQuestion",
 		ResourceType: "question", IsMutation: true,
 		ResourceID: questionnaireID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -381,6 +386,7 @@ func (s *CheckinsService) UpdateQuestion(ctx context.Context, questionID int64,
 		Service: "Checkins", Operation: "UpdateQuestion",
 		ResourceType: "question", IsMutation: true,
 		ResourceID: questionID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -430,6 +436,54 @@ func (s *CheckinsService) UpdateQuestion(ctx context.Context, questionID int64,
 	return &question, nil
 }
 
+// PauseQuestion pauses a check-in question so it stops asking for answers.
+func (s *CheckinsService) PauseQuestion(ctx context.Context, questionID int64) (err error) {
+	op := OperationInfo{
+		Service: "Checkins", Operation: "PauseQuestion",
+		ResourceType: "question", IsMutation: true,
+		ResourceID: questionID,
+		AccountID:  s.client.accountID,
+	}
+	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
+		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
+			return
+		}
+	}
+	start := time.Now()
+	ctx = s.client.parent.hooks.OnOperationStart(ctx, op)
+	defer func() { s.client.parent.hooks.OnOperationEnd(ctx, op, err, time.Since(start)) }()
+
+	resp, err := s.client.parent.gen.PauseQuestionWithResponse(ctx, s.client.accountID, questionID)
+	if err != nil {
+		return err
+	}
+	return checkResponse(resp.HTTPResponse, resp.Body)
+}
+
+// ResumeQuestion resumes a paused check-in question.
+func (s *CheckinsService) ResumeQuestion(ctx context.Context, questionID int64) (err error) {
+	op := OperationInfo{
+		Service: "Checkins", Operation: "ResumeQuestion",
+		ResourceType: "question", IsMutation: true,
+		ResourceID: questionID,
+		AccountID:  s.client.accountID,
+	}
+	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
+		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
+			return
+		}
+	}
+	start := time.Now()
+	ctx = s.client.parent.hooks.OnOperationStart(ctx, op)
+	defer func() { s.client.parent.hooks.OnOperationEnd(ctx, op, err, time.Since(start)) }()
+
+	resp, err := s.client.parent.gen.ResumeQuestionWithResponse(ctx, s.client.accountID, questionID)
+	if err != nil {
+		return err
+	}
+	return checkResponse(resp.HTTPResponse, resp.Body)
+}
+
 // ListAnswers returns all answers for a question.
 //
 // By default, returns all answers (no limit). Use Limit to cap results.
@@ -447,6 +501,7 @@ func (s *CheckinsService) ListAnswers(ctx context.Context, questionID int64, opt
 		Service: "Checkins", Operation: "ListAnswers",
 		ResourceType: "answer", IsMutation: false,
 		ResourceID: questionID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -468,6 +523,7 @@ func (s *CheckinsService) ListAnswers(ctx context.Context, questionID int64, opt
 
 	// Capture total count from X-Total-Count header
 	totalCount := parseTotalCount(resp.HTTPResponse)
+	totalPages := parseTotalPages(resp.HTTPResponse, firstPageLen(resp.JSON200))
 
 	// Parse first page
 	var answers []QuestionAnswer
@@ -479,7 +535,7 @@ func (s *CheckinsService) ListAnswers(ctx context.Context, questionID int64, opt
 
 	// Handle single page fetch (--page flag)
 	if opts != nil && opts.Page > 0 {
-		return &AnswerListResult{Answers: answers, Meta: ListMeta{TotalCount: totalCount}}, nil
+		return &AnswerListResult{Answers: answers, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages}}, nil
 	}
 
 	// Determine limit: 0 = all (default for answers), >0 = specific limit
@@ -490,7 +546,7 @@ func (s *CheckinsService) ListAnswers(ctx context.Context, questionID int64, opt
 
 	// Check if we already have enough items
 	if limit > 0 && len(answers) >= limit {
-		return &AnswerListResult{Answers: answers[:limit], Meta: ListMeta{TotalCount: totalCount, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(answers), limit)}}, nil
+		return &AnswerListResult{Answers: answers[:limit], Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(answers), limit)}}, nil
 	}
 
 	// Follow pagination via Link headers (uses absolute URLs from API, no path construction)
@@ -508,7 +564,7 @@ func (s *CheckinsService) ListAnswers(ctx context.Context, questionID int64, opt
 		answers = append(answers, questionAnswerFromGenerated(ga))
 	}
 
-	return &AnswerListResult{Answers: answers, Meta: ListMeta{TotalCount: totalCount, Truncated: truncated}}, nil
+	return &AnswerListResult{Answers: answers, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: truncated}}, nil
 }
 
 // ListAnswersByPerson returns all answers for a question posted by a specific person.
@@ -525,6 +581,7 @@ func (s *CheckinsService) ListAnswersByPerson(ctx context.Context, questionID, p
 		Service: "Checkins", Operation: "ListAnswersByPerson",
 		ResourceType: "answer", IsMutation: false,
 		ResourceID: questionID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -544,6 +601,7 @@ func (s *CheckinsService) ListAnswersByPerson(ctx context.Context, questionID, p
 	}
 
 	totalCount := parseTotalCount(resp.HTTPResponse)
+	totalPages := parseTotalPages(resp.HTTPResponse, firstPageLen(resp.JSON200))
 
 	var answers []QuestionAnswer
 	if resp.JSON200 != nil {
@@ -553,7 +611,7 @@ func (s *CheckinsService) ListAnswersByPerson(ctx context.Context, questionID, p
 	}
 
 	if opts != nil && opts.Page > 0 {
-		return &AnswerListResult{Answers: answers, Meta: ListMeta{TotalCount: totalCount}}, nil
+		return &AnswerListResult{Answers: answers, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages}}, nil
 	}
 
 	limit := 0
@@ -562,7 +620,7 @@ func (s *CheckinsService) ListAnswersByPerson(ctx context.Context, questionID, p
 	}
 
 	if limit > 0 && len(answers) >= limit {
-		return &AnswerListResult{Answers: answers[:limit], Meta: ListMeta{TotalCount: totalCount, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(answers), limit)}}, nil
+		return &AnswerListResult{Answers: answers[:limit], Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(answers), limit)}}, nil
 	}
 
 	rawMore, truncated, err := s.client.parent.followPagination(ctx, resp.HTTPResponse, len(answers), limit)
@@ -578,7 +636,52 @@ func (s *CheckinsService) ListAnswersByPerson(ctx context.Context, questionID, p
 		answers = append(answers, questionAnswerFromGenerated(ga))
 	}
 
-	return &AnswerListResult{Answers: answers, Meta: ListMeta{TotalCount: totalCount, Truncated: truncated}}, nil
+	return &AnswerListResult{Answers: answers, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: truncated}}, nil
+}
+
+// ListAnswersByDate returns the answers for a question grouped on date, which
+// must be in YYYY-MM-DD format. The endpoint has no date filter, so answers
+// are fetched with ListAnswers and filtered on GroupOn; hooks observe the
+// ListAnswers operation.
+//
+// By default, every page is fetched and all matching answers are returned.
+//
+// Pagination options:
+//   - Limit: maximum number of matching answers to return (0 = all)
+//   - Page: if non-zero, only the first page is fetched and filtered.
+//     NOTE: The page number itself is not yet honored due to OpenAPI client
+//     limitations.
+func (s *CheckinsService) ListAnswersByDate(ctx context.Context, questionID int64, date string, opts *AnswerListOptions) (*AnswerListResult, error) {
+	if date == "" {
+		return nil, ErrUsage("date is required")
+	}
+	groupOn, err := types.ParseDate(date)
+	if err != nil {
+		return nil, ErrUsage("date must be in YYYY-MM-DD format")
+	}
+
+	// Fetch unlimited so Limit applies to the matching answers.
+	fetch := AnswerListOptions{}
+	if opts != nil {
+		fetch.Page = opts.Page
+	}
+	result, err := s.ListAnswers(ctx, questionID, &fetch)
+	if err != nil {
+		return nil, err
+	}
+
+	var answers []QuestionAnswer
+	for _, a := range result.Answers {
+		if a.GroupOn == groupOn.String() {
+			answers = append(answers, a)
+		}
+	}
+	if opts != nil && opts.Limit > 0 && len(answers) > opts.Limit {
+		answers = answers[:opts.Limit]
+		result.Meta.Truncated = true
+	}
+	result.Answers = answers
+	return result, nil
 }
 
 // GetAnswer returns a question answer by ID.
@@ -587,6 +690,7 @@ func (s *CheckinsService) GetAnswer(ctx context.Context, answerID int64) (result
 		Service: "Checkins", Operation: "GetAnswer",
 		ResourceType: "answer", IsMutation: false,
 		ResourceID: answerID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -620,6 +724,7 @@ func (s *CheckinsService) CreateAnswer(ctx context.Context, questionID int64, re
 		Service: "Checkins", Operation: "CreateAnswer",
 		ResourceType: "answer", IsMutation: true,
 		ResourceID: questionID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -667,6 +772,7 @@ func (s *CheckinsService) UpdateAnswer(ctx context.Context, answerID int64, req
 		Service: "Checkins", Operation: "UpdateAnswer",
 		ResourceType: "answer", IsMutation: true,
 		ResourceID: answerID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
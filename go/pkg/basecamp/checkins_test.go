@@ -3,6 +3,7 @@ package basecamp
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -660,7 +661,7 @@ func TestUpdateAnswerRequest_MarshalWithGroupOn(t *testing.T) {
 	}
 }
 
-func testCheckinsServer(t *testing.T, handler http.HandlerFunc) *CheckinsService {
+func testCheckinsServer(t *testing.T, handler http.HandlerFunc, opts ...ClientOption) *CheckinsService {
 	t.Helper()
 	server := httptest.NewServer(handler)
 	t.Cleanup(server.Close)
@@ -668,7 +669,7 @@ func testCheckinsServer(t *testing.T, handler http.HandlerFunc) *CheckinsService
 	cfg := DefaultConfig()
 	cfg.BaseURL = server.URL
 	token := &StaticTokenProvider{Token: "test-token"}
-	client := NewClient(cfg, token)
+	client := NewClient(cfg, token, opts...)
 	account := client.ForAccount("99999")
 	return account.Checkins()
 }
@@ -1034,3 +1035,121 @@ func TestCheckinsService_ListAnswersByPerson_Pagination(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckinsService_ListAnswersByDate(t *testing.T) {
+	var requestedPath, rawQuery string
+	hooks := &recordingHooks{}
+	svc := testCheckinsServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requestedPath = r.URL.Path
+		rawQuery = r.URL.RawQuery
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		w.Write([]byte(`[
+			{"id":1,"group_on":"2022-10-27","content":"<p>Earlier</p>"},
+			{"id":2,"group_on":"2022-10-28","content":"<p>First</p>"},
+			{"id":3,"group_on":"2022-10-28","content":"<p>Second</p>"},
+			{"id":4,"group_on":"2022-10-29","content":"<p>Later</p>"}
+		]`))
+	}, WithHooks(hooks))
+
+	result, err := svc.ListAnswersByDate(context.Background(), 1069479410, "2022-10-28", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if requestedPath != "/99999/questions/1069479410/answers.json" {
+		t.Errorf("expected path /99999/questions/1069479410/answers.json, got %q", requestedPath)
+	}
+	if rawQuery != "" {
+		t.Errorf("expected no query parameters, got %q", rawQuery)
+	}
+	if len(result.Answers) != 2 || result.Answers[0].ID != 2 || result.Answers[1].ID != 3 {
+		t.Fatalf("expected answers 2 and 3, got %+v", result.Answers)
+	}
+	if len(hooks.opStartCalls) != 1 || hooks.opStartCalls[0].Operation != "ListAnswers" {
+		t.Errorf("unexpected operation info: %+v", hooks.opStartCalls)
+	}
+
+	limited, err := svc.ListAnswersByDate(context.Background(), 1069479410, "2022-10-28", &AnswerListOptions{Limit: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(limited.Answers) != 1 || limited.Answers[0].ID != 2 || !limited.Meta.Truncated {
+		t.Errorf("expected answer 2 with Truncated set, got %+v (meta %+v)", limited.Answers, limited.Meta)
+	}
+}
+
+func TestCheckinsService_ListAnswersByDate_InvalidDate(t *testing.T) {
+	svc := testCheckinsServer(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("expected no HTTP request for an invalid date")
+	})
+
+	for _, date := range []string{"", "10/28/2022", "2022-10-28&page=2"} {
+		_, err := svc.ListAnswersByDate(context.Background(), 1069479410, date, nil)
+		var sdkErr *Error
+		if !errors.As(err, &sdkErr) || sdkErr.Code != CodeUsage {
+			t.Errorf("date %q: expected usage error, got %v", date, err)
+		}
+	}
+}
+
+func TestCheckinsService_PauseResumeQuestion(t *testing.T) {
+	tests := []struct {
+		name      string
+		method    string
+		operation string
+		call      func(*CheckinsService) error
+	}{
+		{"pause", "POST", "PauseQuestion", func(s *CheckinsService) error {
+			return s.PauseQuestion(context.Background(), 1069479400)
+		}},
+		{"resume", "DELETE", "ResumeQuestion", func(s *CheckinsService) error {
+			return s.ResumeQuestion(context.Background(), 1069479400)
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hooks := &recordingHooks{}
+			svc := testCheckinsServer(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != tt.method {
+					t.Errorf("expected %s, got %s", tt.method, r.Method)
+				}
+				if r.URL.Path != "/99999/questions/1069479400/pause.json" {
+					t.Errorf("unexpected path: %s", r.URL.Path)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(200)
+				w.Write([]byte(`{"paused":true}`))
+			}, WithHooks(hooks))
+
+			if err := tt.call(svc); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(hooks.opStartCalls) != 1 || len(hooks.opEndCalls) != 1 {
+				t.Fatalf("expected 1 operation start/end, got %d/%d", len(hooks.opStartCalls), len(hooks.opEndCalls))
+			}
+			op := hooks.opStartCalls[0]
+			if op.Operation != tt.operation || !op.IsMutation || op.ResourceID != 1069479400 {
+				t.Errorf("unexpected operation info: %+v", op)
+			}
+		})
+	}
+}
+
+func TestCheckinsService_PauseQuestion_NotFound(t *testing.T) {
+	svc := testCheckinsServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(404)
+		w.Write([]byte(`{"error":"Not found"}`))
+	})
+
+	err := svc.PauseQuestion(context.Background(), 1)
+	apiErr, ok := errors.AsType[*Error](err)
+	if !ok {
+		t.Fatalf("expected *Error, got %T: %v", err, err)
+	}
+	if apiErr.Code != CodeNotFound {
+		t.Errorf("expected code %q, got %q", CodeNotFound, apiErr.Code)
+	}
+}
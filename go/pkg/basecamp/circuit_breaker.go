@@ -1,6 +1,7 @@
 package basecamp
 
 import (
+	"context"
 	"sync"
 	"time"
 )
@@ -134,7 +135,8 @@ func (cb *circuitBreaker) Allow() bool {
 }
 
 // RecordSuccess records a successful request.
-func (cb *circuitBreaker) RecordSuccess() {
+// Returns true if the success closed a half-open circuit.
+func (cb *circuitBreaker) RecordSuccess() (closed bool) {
 	cb.mu.Lock()
 	defer cb.mu.Unlock()
 
@@ -148,15 +150,18 @@ func (cb *circuitBreaker) RecordSuccess() {
 			cb.state = stateClosed
 			cb.failures = 0
 			cb.successes = 0
+			return true
 		}
 	case stateClosed:
 		// Reset consecutive failure count on success
 		cb.failures = 0
 	}
+	return false
 }
 
 // RecordFailure records a failed request.
-func (cb *circuitBreaker) RecordFailure() {
+// Returns true if the failure opened the circuit.
+func (cb *circuitBreaker) RecordFailure() (opened bool) {
 	cb.mu.Lock()
 	defer cb.mu.Unlock()
 
@@ -171,13 +176,16 @@ func (cb *circuitBreaker) RecordFailure() {
 		// Check both consecutive failures and failure rate
 		if cb.failures >= cb.config.FailureThreshold || cb.checkFailureRate() {
 			cb.state = stateOpen
+			return true
 		}
 
 	case stateHalfOpen:
 		// Any failure in half-open state opens the circuit
 		cb.state = stateOpen
 		cb.successes = 0
+		return true
 	}
+	return false
 }
 
 // recordInWindow adds a result to the sliding window.
@@ -266,3 +274,41 @@ func (r *circuitBreakerRegistry) get(scope string) *circuitBreaker {
 	r.breakers[scope] = cb
 	return cb
 }
+
+// CircuitBreaker is the interface an external circuit breaker implements to
+// be fed by CircuitBreakerHook.
+type CircuitBreaker interface {
+	// Success records an operation that completed without error.
+	Success()
+	// Failure records an operation that failed with a server-side error.
+	Failure()
+}
+
+// CircuitBreakerHook adapts a CircuitBreaker to the Hooks interface so an
+// application's own breaker can observe SDK operations. Combine it with
+// other hooks using NewChainHooks.
+//
+// Outcomes are classified like the built-in breaker: 5xx responses and
+// network errors are failures; client errors (4xx, validation, auth) and
+// context cancellation are neither failures nor successes. The hook only
+// reports outcomes; it does not reject operations.
+type CircuitBreakerHook struct {
+	NoopHooks
+	cb CircuitBreaker
+}
+
+// NewCircuitBreakerHook returns a CircuitBreakerHook that reports operation
+// outcomes to cb.
+func NewCircuitBreakerHook(cb CircuitBreaker) *CircuitBreakerHook {
+	return &CircuitBreakerHook{cb: cb}
+}
+
+// OnOperationEnd reports the operation outcome to the circuit breaker.
+func (h *CircuitBreakerHook) OnOperationEnd(_ context.Context, _ OperationInfo, err error, _ time.Duration) {
+	switch {
+	case err == nil:
+		h.cb.Success()
+	case shouldTripCircuit(err):
+		h.cb.Failure()
+	}
+}
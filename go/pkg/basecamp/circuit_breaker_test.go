@@ -1,6 +1,8 @@
 package basecamp
 
 import (
+	"context"
+	"errors"
 	"sync"
 	"testing"
 	"time"
@@ -221,3 +223,32 @@ func TestCircuitBreaker_ConcurrentAccess(t *testing.T) {
 	// Just verify no panic/race; state is indeterminate
 	_ = cb.State()
 }
+
+// countingBreaker is a CircuitBreaker that counts reported outcomes.
+type countingBreaker struct {
+	successes, failures int
+}
+
+func (b *countingBreaker) Success() { b.successes++ }
+func (b *countingBreaker) Failure() { b.failures++ }
+
+func TestCircuitBreakerHook_ClassifiesOutcomes(t *testing.T) {
+	ctx := context.Background()
+	op := OperationInfo{Service: "Todos", Operation: "Get"}
+
+	cb := &countingBreaker{}
+	hook := NewCircuitBreakerHook(cb)
+
+	hook.OnOperationEnd(ctx, op, nil, time.Second)
+	hook.OnOperationEnd(ctx, op, ErrAPI(503, "unavailable"), time.Second)
+	hook.OnOperationEnd(ctx, op, ErrNetwork(errors.New("connection reset")), time.Second)
+	hook.OnOperationEnd(ctx, op, ErrNotFound("todo", "1"), time.Second)
+	hook.OnOperationEnd(ctx, op, ErrNetwork(context.Canceled), time.Second)
+
+	if cb.successes != 1 {
+		t.Errorf("successes = %d, want 1", cb.successes)
+	}
+	if cb.failures != 2 {
+		t.Errorf("failures = %d, want 2 (5xx and network only)", cb.failures)
+	}
+}
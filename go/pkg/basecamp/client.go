@@ -3,17 +3,21 @@ package basecamp
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log/slog"
 	"math/rand"
 	"net/http"
 	"net/url"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
 
+	"golang.org/x/sync/errgroup"
+
 	"github.com/basecamp/basecamp-sdk/go/pkg/generated"
 )
 
@@ -39,12 +43,36 @@ type Client struct {
 	httpOpts      HTTPOptions
 	hooks         Hooks
 
-	// Generated client (single shared instance, account passed per operation)
-	genOnce sync.Once
+	// Response body size limits (see WithMaxResponseSize, WithMaxErrorBodySize)
+	maxResponseBytes  int64
+	maxErrorBodyBytes int64
+
+	// emptyBaseURL records a WithBaseURL("") option, reported as
+	// ErrEmptyBaseURL when the client is built.
+	emptyBaseURL bool
+
+	// retryKeyedMutations enables retries for mutations that carry an
+	// idempotency key (see WithRetryMutationsWithIdempotencyKey).
+	retryKeyedMutations bool
+
+	// requestSlots bounds in-flight requests made through doRequestURL
+	// (see WithConcurrencyLimit). Nil means no limit.
+	requestSlots chan struct{}
+
+	// requestEditors run on every outgoing request after authentication
+	// (see WithRequestEditor).
+	requestEditors []RequestEditorFn
+
+	// opts are the options the client was built with, replayed by Clone.
+	opts []ClientOption
+
+	// Generated client (single shared instance, account passed per operation).
+	// The lock fields are pointers so withHTTPTimeout can copy the struct.
+	genOnce *sync.Once
 	gen     *generated.ClientWithResponses
 
 	// Authorization service (account-independent)
-	authMu        sync.Mutex
+	authMu        *sync.Mutex
 	authorization *AuthorizationService
 }
 
@@ -59,12 +87,22 @@ type Client struct {
 //
 // AccountClient shares the parent Client's generated API client and HTTP
 // resources. Creating multiple AccountClients via ForAccount is lightweight.
+// Use Parent to reach Client-level services and settings:
+//
+//	info, err := account.Parent().Authorization().GetInfo(ctx, nil)
+//	baseURL := account.Parent().Config().BaseURL
 type AccountClient struct {
 	parent    *Client
 	accountID string
-	mu        sync.Mutex // protects lazy service initialization
+	timeout   time.Duration // whole-call deadline for Get/Post/Put/Delete/GetAll (see WithTimeout)
+	mu        sync.Mutex    // protects lazy service initialization
 
 	// Services (lazy-initialized, protected by mu)
+	accountServices
+}
+
+// accountServices holds an AccountClient's lazily created services.
+type accountServices struct {
 	projects              *ProjectsService
 	todos                 *TodosService
 	todosets              *TodosetsService
@@ -122,6 +160,34 @@ func (r *Response) UnmarshalData(v any) error {
 	return json.Unmarshal(r.Data, v)
 }
 
+// LinkNext returns the rel="next" URL from the response's Link header, or ""
+// when there is no next page. Relative URLs are returned unresolved.
+func (r *Response) LinkNext() string {
+	return r.link("next")
+}
+
+// LinkPrev returns the rel="prev" URL from the response's Link header, or "".
+func (r *Response) LinkPrev() string {
+	return r.link("prev")
+}
+
+// LinkFirst returns the rel="first" URL from the response's Link header, or "".
+func (r *Response) LinkFirst() string {
+	return r.link("first")
+}
+
+// LinkLast returns the rel="last" URL from the response's Link header, or "".
+func (r *Response) LinkLast() string {
+	return r.link("last")
+}
+
+func (r *Response) link(rel string) string {
+	if r == nil {
+		return ""
+	}
+	return ParseLinkHeader(r.Headers.Get("Link"))[rel]
+}
+
 // ClientOption configures a Client.
 type ClientOption func(*Client)
 
@@ -139,6 +205,23 @@ func WithUserAgent(ua string) ClientOption {
 	}
 }
 
+// WithBaseURL overrides the Config's BaseURL for this client, for example to
+// target a Basecamp-compatible self-hosted API. The caller's Config is not
+// modified. The URL must use HTTPS unless it points to localhost; like an
+// insecure Config.BaseURL, an HTTP URL makes NewClient panic and
+// NewClientWithError return ErrInsecureBaseURL. An empty rawURL is reported
+// the same way, as ErrEmptyBaseURL.
+func WithBaseURL(rawURL string) ClientOption {
+	return func(client *Client) {
+		if rawURL == "" {
+			client.emptyBaseURL = true
+			return
+		}
+		client.emptyBaseURL = false
+		client.cfg.BaseURL = NormalizeBaseURL(rawURL)
+	}
+}
+
 // WithLogger sets a custom slog logger for debug output.
 // By default, the client uses a no-op logger (silent).
 // Passing nil is safe and will use the default no-op logger.
@@ -157,6 +240,22 @@ func WithCache(cache *Cache) ClientOption {
 	}
 }
 
+// RequestEditorFn edits an outgoing request before it is sent.
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// WithRequestEditor adds fn to the editors run on every request the client
+// sends, including each retry attempt and requests made by service methods.
+// Editors run after the authentication and SDK headers are set, in the order
+// they were added; use them to attach correlation IDs or audit headers. If an
+// editor returns an error, the request is not sent and that error is returned.
+func WithRequestEditor(fn RequestEditorFn) ClientOption {
+	return func(client *Client) {
+		if fn != nil {
+			client.requestEditors = append(client.requestEditors, fn)
+		}
+	}
+}
+
 // WithAuthStrategy sets a custom authentication strategy.
 // The default strategy is BearerAuth, which sets the Authorization header
 // with a Bearer token from the token provider.
@@ -179,12 +278,32 @@ func WithAuthStrategy(strategy AuthStrategy) ClientOption {
 //   - Follows pagination via Link headers
 //
 // Configuration options:
+//   - WithBaseURL(u)      - Override Config.BaseURL
 //   - WithTimeout(d)      - Request timeout (default: 30s)
+//   - WithRequestTimeout(d) - Per-attempt deadline, retried like a network error
 //   - WithMaxRetries(n)   - Total attempt count for GET (default: 3, minimum 1)
 //   - WithCache(c)        - Enable ETag-based caching
 //   - WithTransport(t)    - Custom http.RoundTripper
+//   - WithMaxResponseSize(n) - Max response body size in bytes (default: 50 MB)
 //   - WithLogger(l)       - slog.Logger for debug output
+//
+// NewClient panics if the configuration is invalid (see Config.Validate and
+// HTTPOptions.Validate). Use NewClientWithError to handle configuration
+// errors without recovering from a panic.
 func NewClient(cfg *Config, tokenProvider TokenProvider, opts ...ClientOption) *Client {
+	c, err := NewClientWithError(cfg, tokenProvider, opts...)
+	if err != nil {
+		panic("basecamp: " + err.Error())
+	}
+	return c
+}
+
+// NewClientWithError creates a new API client like NewClient, but returns
+// configuration problems as an error instead of panicking. The error joins
+// every problem found; use errors.Is with ErrInsecureBaseURL, ErrEmptyBaseURL,
+// ErrInvalidTimeout, ErrInvalidMaxRetries, ErrInvalidMaxPages, or
+// ErrInvalidMaxResponseSize to inspect it.
+func NewClientWithError(cfg *Config, tokenProvider TokenProvider, opts ...ClientOption) (*Client, error) {
 	// Deep-copy the config to prevent post-construction mutation.
 	// The client captures configuration at construction time.
 	cfgCopy := *cfg
@@ -195,6 +314,28 @@ func NewClient(cfg *Config, tokenProvider TokenProvider, opts ...ClientOption) *
 		logger:        slog.New(discardHandler{}),
 		hooks:         NoopHooks{},
 		httpOpts:      DefaultHTTPOptions(),
+
+		maxResponseBytes:  MaxResponseBodyBytes,
+		maxErrorBodyBytes: MaxErrorBodyBytes,
+
+		opts: slices.Clone(opts),
+
+		genOnce: new(sync.Once),
+		authMu:  new(sync.Mutex),
+	}
+
+	// Seed HTTP options from the config; explicit options below win.
+	if cfg.MaxRetries != 0 {
+		c.httpOpts.MaxRetries = cfg.MaxRetries
+	}
+	if cfg.Timeout != 0 {
+		c.httpOpts.Timeout = cfg.Timeout
+	}
+	if cfg.MaxPages != 0 {
+		c.httpOpts.MaxPages = cfg.MaxPages
+	}
+	if cfg.BaseDelay != 0 {
+		c.httpOpts.BaseDelay = cfg.BaseDelay
 	}
 
 	// Apply options (may modify httpOpts)
@@ -202,6 +343,11 @@ func NewClient(cfg *Config, tokenProvider TokenProvider, opts ...ClientOption) *
 		opt(c)
 	}
 
+	// Validate configuration
+	if err := errors.Join(c.cfg.Validate(), c.httpOpts.Validate(), c.validateOptions()); err != nil {
+		return nil, err
+	}
+
 	// Default to BearerAuth if no custom auth strategy was provided
 	if c.authStrategy == nil {
 		c.authStrategy = &BearerAuth{TokenProvider: c.tokenProvider}
@@ -232,32 +378,47 @@ func NewClient(cfg *Config, tokenProvider TokenProvider, opts ...ClientOption) *
 		},
 	}
 
-	// Validate configuration
-	// Skip HTTPS validation for localhost (used in tests)
-	if c.cfg.BaseURL != "" && !isLocalhost(c.cfg.BaseURL) {
-		if err := requireHTTPS(c.cfg.BaseURL); err != nil {
-			panic("basecamp: base URL must use HTTPS: " + c.cfg.BaseURL)
-		}
-	}
-	if c.httpOpts.Timeout <= 0 {
-		panic("basecamp: timeout must be positive")
-	}
-	if c.httpOpts.MaxRetries < 1 {
-		// MaxRetries names the total attempt count used by the retry loops in
-		// doRequestURL and fetchAPIDownload. Zero attempts is always a
-		// misconfiguration; reject it here so both loops can assume >= 1.
-		panic("basecamp: max retries must be at least 1")
-	}
-	if c.httpOpts.MaxPages <= 0 {
-		panic("basecamp: max pages must be positive")
-	}
-
 	// Initialize cache if enabled and not overridden
 	if c.cache == nil && cfg.CacheEnabled {
 		c.cache = NewCache(cfg.CacheDir)
 	}
 
-	return c
+	return c, nil
+}
+
+// withHTTPTimeout returns a Client that shares c's configuration, hooks,
+// cache, and transport but whose HTTP client times out after d. It has its
+// own generated client bound to that HTTP client.
+func (c *Client) withHTTPTimeout(d time.Duration) *Client {
+	httpClient := *c.httpClient
+	httpClient.Timeout = d
+	httpOpts := c.httpOpts
+	httpOpts.Timeout = d
+
+	// Copy every setting, including the concurrency limit's shared slots,
+	// then give the copy its own generated client and authorization service.
+	derived := *c
+	derived.httpClient = &httpClient
+	derived.httpOpts = httpOpts
+	derived.opts = append(slices.Clip(c.opts), WithTimeout(d))
+	derived.genOnce = new(sync.Once)
+	derived.gen = nil
+	derived.authMu = new(sync.Mutex)
+	derived.authorization = nil
+	derived.initGeneratedClient()
+	return &derived
+}
+
+// Clone returns a new Client built from c's Config and token provider with
+// the options c was created with, followed by opts. Later options win, so
+// opts can override c's logger, hooks, cache, or HTTP settings for some
+// accounts of a multi-tenant process. The clone has its own HTTP client and
+// generated client, and a cache of its own unless an option passes in a
+// shared one; changing it never affects c.
+//
+// Like NewClient, Clone panics if the resulting configuration is invalid.
+func (c *Client) Clone(opts ...ClientOption) *Client {
+	return NewClient(c.cfg, c.tokenProvider, append(slices.Clip(c.opts), opts...)...)
 }
 
 // ForAccount returns an AccountClient bound to the specified Basecamp account.
@@ -291,40 +452,112 @@ func (c *Client) ForAccount(accountID string) *AccountClient {
 	}
 }
 
+// ForAccountID is like ForAccount but takes the numeric account ID as
+// returned by the authorization endpoint, avoiding a string conversion at
+// every call site. ForAccountID panics if accountID is not positive.
+//
+// Example:
+//
+//	info, _ := client.Authorization().GetInfo(ctx, nil)
+//	account := client.ForAccountID(info.Accounts[0].ID)
+func (c *Client) ForAccountID(accountID int64) *AccountClient {
+	if accountID <= 0 {
+		panic("basecamp: ForAccountID requires positive account ID, got: " + strconv.FormatInt(accountID, 10))
+	}
+	return c.ForAccount(strconv.FormatInt(accountID, 10))
+}
+
 // AccountID returns the account ID this client is bound to.
 func (ac *AccountClient) AccountID() string {
 	return ac.accountID
 }
 
+// Reset discards the services created so far, so the next call to Projects,
+// Todos, and the other service accessors creates a new instance. Services
+// already obtained by callers keep working. Reset is meant for test teardown
+// when an AccountClient is reused across cases, e.g. t.Cleanup(account.Reset).
+func (ac *AccountClient) Reset() {
+	ac.mu.Lock()
+	defer ac.mu.Unlock()
+	ac.accountServices = accountServices{}
+}
+
+// Parent returns the Client this AccountClient was created from. For a client
+// returned by WithTimeout, it is the derived Client that carries the timeout.
+func (ac *AccountClient) Parent() *Client {
+	return ac.parent
+}
+
+// WithTimeout returns a copy of the AccountClient whose requests use timeout d
+// instead of the Client's Timeout. The receiver is not modified.
+//
+// Get, Post, Put, Delete, GetAll, and GetAllWithLimit on the returned client
+// run under context.WithTimeout(ctx, d), so d bounds the whole call including
+// retries and pagination. Service methods obtained from the returned client
+// (for example Events().List) limit each HTTP request they make to d, the way
+// the Client's Timeout does. Downloads are streamed and are not limited.
+//
+// The returned client has its own lazily created services but shares the
+// Client's token provider, hooks, cache, transport, and concurrency limit.
+func (ac *AccountClient) WithTimeout(d time.Duration) *AccountClient {
+	if d <= 0 {
+		panic("basecamp: AccountClient.WithTimeout requires a positive duration")
+	}
+	parent := ac.parent.withHTTPTimeout(d)
+	return &AccountClient{
+		parent:    parent,
+		accountID: ac.accountID,
+		timeout:   d,
+	}
+}
+
+// callContext applies the WithTimeout deadline, if any, to ctx.
+func (ac *AccountClient) callContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if ac.timeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, ac.timeout)
+}
+
 // Get performs an account-scoped GET request.
 func (ac *AccountClient) Get(ctx context.Context, path string) (*Response, error) {
+	ctx, cancel := ac.callContext(ctx)
+	defer cancel()
 	return ac.parent.doRequest(ctx, "GET", ac.accountPath(path), nil)
 }
 
 // Post performs an account-scoped POST request with a JSON body.
 func (ac *AccountClient) Post(ctx context.Context, path string, body any) (*Response, error) {
+	ctx, cancel := ac.callContext(ctx)
+	defer cancel()
 	return ac.parent.doRequest(ctx, "POST", ac.accountPath(path), body)
 }
 
 // Put performs an account-scoped PUT request with a JSON body.
 func (ac *AccountClient) Put(ctx context.Context, path string, body any) (*Response, error) {
+	ctx, cancel := ac.callContext(ctx)
+	defer cancel()
 	return ac.parent.doRequest(ctx, "PUT", ac.accountPath(path), body)
 }
 
 // Delete performs an account-scoped DELETE request.
 func (ac *AccountClient) Delete(ctx context.Context, path string) (*Response, error) {
+	ctx, cancel := ac.callContext(ctx)
+	defer cancel()
 	return ac.parent.doRequest(ctx, "DELETE", ac.accountPath(path), nil)
 }
 
 // GetAll fetches all pages for an account-scoped paginated resource.
 func (ac *AccountClient) GetAll(ctx context.Context, path string) ([]json.RawMessage, error) {
-	return ac.parent.GetAllWithLimit(ctx, ac.accountPath(path), 0)
+	return ac.GetAllWithLimit(ctx, path, 0)
 }
 
 // GetAllWithLimit fetches pages for an account-scoped paginated resource up to a limit.
 // If limit is 0, it fetches all pages (same as GetAll).
 // If limit > 0, it stops after collecting at least limit items.
 func (ac *AccountClient) GetAllWithLimit(ctx context.Context, path string, limit int) ([]json.RawMessage, error) {
+	ctx, cancel := ac.callContext(ctx)
+	defer cancel()
 	return ac.parent.GetAllWithLimit(ctx, ac.accountPath(path), limit)
 }
 
@@ -365,7 +598,7 @@ func (c *Client) initGeneratedClient() {
 			if err := c.assertCredentialOrigin(req); err != nil {
 				return err
 			}
-			if err := c.authStrategy.Authenticate(ctx, req); err != nil {
+			if err := c.authStrategy.Authenticate(c.withRequestAccountID(ctx, req), req); err != nil {
 				return err
 			}
 			req.Header.Set("User-Agent", c.userAgent)
@@ -375,7 +608,10 @@ func (c *Client) initGeneratedClient() {
 				req.Header.Set("Content-Type", "application/json")
 			}
 			req.Header.Set("Accept", "application/json")
-			return nil
+			if key := idempotencyKeyFromContext(ctx); key != "" {
+				req.Header.Set(idempotencyKeyHeader, key)
+			}
+			return c.editRequest(ctx, req)
 		}
 		gen, err := generated.NewClientWithResponses(serverURL,
 			generated.WithHTTPClient(c.httpClient),
@@ -387,10 +623,44 @@ func (c *Client) initGeneratedClient() {
 	})
 }
 
+// validateOptions reports invalid values recorded by client options: an empty
+// WithBaseURL and non-positive response body size limits.
+func (c *Client) validateOptions() error {
+	var errs []error
+	if c.emptyBaseURL {
+		errs = append(errs, fmt.Errorf("%w: WithBaseURL(\"\")", ErrEmptyBaseURL))
+	}
+	if c.maxResponseBytes <= 0 {
+		errs = append(errs, fmt.Errorf("%w: response body limit %d", ErrInvalidMaxResponseSize, c.maxResponseBytes))
+	}
+	if c.maxErrorBodyBytes <= 0 {
+		errs = append(errs, fmt.Errorf("%w: error body limit %d", ErrInvalidMaxResponseSize, c.maxErrorBodyBytes))
+	}
+	return errors.Join(errs...)
+}
+
+// editRequest applies the WithRequestEditor editors in order, stopping at
+// the first error.
+func (c *Client) editRequest(ctx context.Context, req *http.Request) error {
+	for _, edit := range c.requestEditors {
+		if err := edit(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func requestHasBody(req *http.Request) bool {
 	return req != nil && req.Body != nil && req.Body != http.NoBody
 }
 
+// logRequest emits a request-lifecycle log record with typed attributes.
+// Using LogAttrs keeps attribute names and kinds consistent across call sites
+// and avoids the key/value pairing mistakes of the variadic any form.
+func logRequest(logger *slog.Logger, level slog.Level, msg string, attrs ...slog.Attr) {
+	logger.LogAttrs(context.Background(), level, msg, attrs...)
+}
+
 // discardHandler is a slog.Handler that discards all log records.
 type discardHandler struct{}
 
@@ -477,6 +747,47 @@ func (c *Client) GetAllWithLimit(ctx context.Context, path string, limit int) ([
 	return allResults, nil
 }
 
+// GetAllForAccounts fetches every page of the same account-scoped resource
+// from each of accountIDs concurrently and returns the items keyed by account
+// ID. path is account-less (e.g. "/projects.json"), as for AccountClient.GetAll.
+//
+// At most concurrency accounts are fetched at once; concurrency <= 0 fetches
+// all accounts at once. The first error cancels the remaining requests and is
+// returned with the failing account ID; no partial results are returned.
+func (c *Client) GetAllForAccounts(ctx context.Context, accountIDs []string, path string, concurrency int) (map[string][]json.RawMessage, error) {
+	accounts := make([]*AccountClient, len(accountIDs))
+	for i, id := range accountIDs {
+		if id == "" || strings.Trim(id, "0123456789") != "" {
+			return nil, ErrUsage(fmt.Sprintf("account ID must be numeric, got: %q", id))
+		}
+		accounts[i] = c.ForAccount(id)
+	}
+
+	g, gctx := errgroup.WithContext(ctx)
+	if concurrency > 0 {
+		g.SetLimit(concurrency)
+	}
+
+	var mu sync.Mutex
+	results := make(map[string][]json.RawMessage, len(accounts))
+	for _, ac := range accounts {
+		g.Go(func() error {
+			items, err := ac.GetAllWithLimit(gctx, path, 0)
+			if err != nil {
+				return fmt.Errorf("account %s: %w", ac.accountID, err)
+			}
+			mu.Lock()
+			results[ac.accountID] = items
+			mu.Unlock()
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
 // FollowPagination fetches additional pages following Link headers from an HTTP response.
 // This is used after calling the generated client for the first page.
 // The httpResp should be from the generated client's *WithResponse method.
@@ -605,15 +916,18 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body any) (
 
 func (c *Client) doRequestURL(ctx context.Context, method, url string, body any) (*Response, error) {
 	// Mutations (POST/PUT/DELETE): Don't retry on 429/5xx to avoid duplicating data.
-	// Only retry once after successful 401 token refresh.
-	if method != "GET" {
+	// Only retry once after successful 401 token refresh. A mutation that
+	// carries an idempotency key is deduplicated by the server, so it may opt
+	// into the full retry loop below.
+	keyedRetry := c.retryKeyedMutations && idempotencyKeyFromContext(ctx) != ""
+	if method != "GET" && !keyedRetry {
 		resp, err := c.singleRequest(ctx, method, url, body, 1)
 		if err == nil {
 			return resp, nil
 		}
 		// Only retry if this was a 401 that triggered successful token refresh
 		if apiErr, ok := err.(*Error); ok && apiErr.Retryable && apiErr.Code == CodeAuth {
-			c.logger.Debug("token refreshed, retrying mutation", "method", method)
+			logRequest(c.logger, slog.LevelDebug, "token refreshed, retrying mutation", slog.String("method", method))
 			info := RequestInfo{Method: method, URL: url, Attempt: 1}
 			c.hooks.OnRetry(ctx, info, 2, err)
 			return c.singleRequest(ctx, method, url, body, 2)
@@ -621,7 +935,7 @@ func (c *Client) doRequestURL(ctx context.Context, method, url string, body any)
 		return nil, err
 	}
 
-	// GET requests: Full retry with exponential backoff
+	// GET requests (and keyed mutations): Full retry with exponential backoff
 	var attempt int
 	var lastErr error
 
@@ -651,7 +965,13 @@ func (c *Client) doRequestURL(ctx context.Context, method, url string, body any)
 			return nil, err
 		}
 
-		c.logger.Debug("retrying request", "attempt", attempt, "maxRetries", c.httpOpts.MaxRetries, "delay", delay, "error", lastErr)
+		logRequest(c.logger, slog.LevelDebug, "retrying request",
+			slog.String("method", method),
+			slog.String("url", url),
+			slog.Int("attempt", attempt),
+			slog.Int("maxRetries", c.httpOpts.MaxRetries),
+			slog.Duration("delay", delay),
+			slog.Any("error", lastErr))
 
 		// Notify hooks about the retry
 		info := RequestInfo{Method: method, URL: url, Attempt: attempt}
@@ -669,6 +989,15 @@ func (c *Client) doRequestURL(ctx context.Context, method, url string, body any)
 }
 
 func (c *Client) singleRequest(ctx context.Context, method, url string, body any, attempt int) (*Response, error) {
+	if c.requestSlots != nil {
+		select {
+		case c.requestSlots <- struct{}{}:
+			defer func() { <-c.requestSlots }()
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
 	// Add attempt number to context for hooks in transport layer
 	ctx = contextWithAttempt(ctx, attempt)
 
@@ -693,7 +1022,7 @@ func (c *Client) singleRequest(ctx context.Context, method, url string, body any
 	if err := c.assertCredentialOrigin(req); err != nil {
 		return nil, err
 	}
-	if err := c.authStrategy.Authenticate(ctx, req); err != nil {
+	if err := c.authStrategy.Authenticate(c.withRequestAccountID(ctx, req), req); err != nil {
 		return nil, err
 	}
 	req.Header.Set("User-Agent", c.userAgent)
@@ -701,6 +1030,12 @@ func (c *Client) singleRequest(ctx context.Context, method, url string, body any
 		req.Header.Set("Content-Type", "application/json")
 	}
 	req.Header.Set("Accept", "application/json")
+	if key := idempotencyKeyFromContext(ctx); key != "" {
+		req.Header.Set(idempotencyKeyHeader, key)
+	}
+	if err := c.editRequest(ctx, req); err != nil {
+		return nil, err
+	}
 
 	// Add ETag for cached GET requests. Derive cache key from the Authorization
 	// header applied by the auth strategy, so each credential gets its own namespace.
@@ -709,29 +1044,56 @@ func (c *Client) singleRequest(ctx context.Context, method, url string, body any
 		cacheKey = c.cache.Key(url, "", req.Header.Get("Authorization")) // URL already includes account when needed
 		if etag := c.cache.GetETag(cacheKey); etag != "" {
 			req.Header.Set("If-None-Match", etag)
-			c.logger.Debug("cache conditional request", "etag", etag)
+			logRequest(c.logger, slog.LevelDebug, "cache conditional request", slog.String("url", url), slog.String("etag", etag))
 		}
 	}
 
-	c.logger.Debug("http request", "method", method, "url", url, "attempt", attempt)
+	logRequest(c.logger, slog.LevelDebug, "http request",
+		slog.String("method", method),
+		slog.String("url", url),
+		slog.Int("attempt", attempt))
 
 	// Execute request (hooks are called in transport layer)
+	start := time.Now()
 	resp, err := c.httpClient.Do(req) // #nosec G704 -- SDK HTTP client: URL is caller-configured
+	elapsed := time.Since(start)
 	if err != nil {
+		var gateErr *requestGateError
+		if errors.As(err, &gateErr) {
+			return nil, gateErr.err
+		}
+		logRequest(c.logger, slog.LevelDebug, "http request failed",
+			slog.String("method", method),
+			slog.String("url", url),
+			slog.Int("attempt", attempt),
+			slog.Duration("latency", elapsed),
+			slog.Any("error", err))
 		return nil, ErrNetwork(err)
 	}
 	defer func() { _ = resp.Body.Close() }()
 
-	c.logger.Debug("http response", "status", resp.StatusCode)
+	logRequest(c.logger, slog.LevelDebug, "http response",
+		slog.String("method", method),
+		slog.String("url", url),
+		slog.Int("attempt", attempt),
+		slog.Int("status", resp.StatusCode),
+		slog.Duration("latency", elapsed))
 
 	requestID := resp.Header.Get(requestIDHeader)
 
+	// Count one cache lookup per cached GET; a 304 is counted below, once
+	// the cached body has been read.
+	if cacheKey != "" && resp.StatusCode != http.StatusNotModified {
+		c.cache.recordLookup(false)
+	}
+
 	// Handle response based on status code
 	switch resp.StatusCode {
 	case http.StatusNotModified: // 304
 		if cacheKey != "" {
-			c.logger.Debug("cache hit", "status", 304)
+			logRequest(c.logger, slog.LevelDebug, "cache hit", slog.String("url", url), slog.Int("status", resp.StatusCode))
 			cached := c.cache.GetBody(cacheKey)
+			c.cache.recordLookup(cached != nil)
 			if cached != nil {
 				return &Response{
 					Data:       cached,
@@ -744,9 +1106,9 @@ func (c *Client) singleRequest(ctx context.Context, method, url string, body any
 		return nil, ErrAPI(304, "304 received but no cached response available").withRequestID(requestID)
 
 	case http.StatusOK, http.StatusCreated, http.StatusNoContent:
-		respBody, err := limitedReadAll(resp.Body, MaxResponseBodyBytes)
+		respBody, err := limitedReadAll(resp.Body, c.maxResponseBytes)
 		if err != nil {
-			return nil, fmt.Errorf("failed to read response: %w", err)
+			return nil, fmt.Errorf("failed to read response from %s: %w", url, err)
 		}
 
 		// HTTP 204 No Content has no body by definition. Normalize to JSON null
@@ -760,11 +1122,16 @@ func (c *Client) singleRequest(ctx context.Context, method, url string, body any
 			respBody = json.RawMessage("null")
 		}
 
+		// A successful update or delete makes any cached GET of the same URL stale.
+		if (method == "PUT" || method == "DELETE") && c.cache != nil {
+			_ = c.cache.InvalidateURL(url, req.Header.Get("Authorization")) // Ignore cache write errors
+		}
+
 		// Cache GET responses with ETag
 		if method == "GET" && cacheKey != "" {
 			if etag := resp.Header.Get("ETag"); etag != "" {
-				_ = c.cache.Set(cacheKey, respBody, etag) // Ignore cache write errors
-				c.logger.Debug("cache stored", "etag", etag)
+				_ = c.cache.setURL(cacheKey, url, req.Header.Get("Authorization"), respBody, etag) // Ignore cache write errors
+				logRequest(c.logger, slog.LevelDebug, "cache stored", slog.String("url", url), slog.String("etag", etag))
 			}
 		}
 
@@ -816,7 +1183,7 @@ func (c *Client) singleRequest(ctx context.Context, method, url string, body any
 		}).withRequestID(requestID)
 
 	default:
-		respBody, _ := limitedReadAll(resp.Body, MaxErrorBodyBytes)
+		respBody, _ := limitedReadAll(resp.Body, c.maxErrorBodyBytes)
 		var apiErr struct {
 			Error   string `json:"error"`
 			Message string `json:"message"`
@@ -828,13 +1195,32 @@ func (c *Client) singleRequest(ctx context.Context, method, url string, body any
 			}
 			if msg != "" {
 				// Truncate error messages to prevent information leakage and unbounded memory growth
-				return nil, ErrAPI(resp.StatusCode, truncateString(msg, MaxErrorMessageBytes)).withRequestID(requestID)
+				return nil, statusError(resp.StatusCode, truncateString(msg, MaxErrorMessageBytes)).withRequestID(requestID)
 			}
 		}
-		return nil, ErrAPI(resp.StatusCode, fmt.Sprintf("Request failed (HTTP %d)", resp.StatusCode)).withRequestID(requestID)
+		return nil, statusError(resp.StatusCode, fmt.Sprintf("Request failed (HTTP %d)", resp.StatusCode)).withRequestID(requestID)
 	}
 }
 
+// statusError returns a non-retryable error for a status singleRequest has no
+// dedicated case for, coded for 409, 413, and 422 and CodeAPI otherwise.
+func statusError(status int, msg string) *Error {
+	var e *Error
+	switch status {
+	case http.StatusConflict:
+		e = ErrConflict("Resource", "")
+	case http.StatusRequestEntityTooLarge:
+		e = ErrPayloadTooLarge(0)
+	default:
+		e = ErrAPI(status, "")
+		if status == http.StatusUnprocessableEntity {
+			e.Code = CodeValidation
+		}
+	}
+	e.Message = msg
+	return e
+}
+
 func (c *Client) buildURL(path string) (string, error) {
 	// Schemes are case-insensitive (RFC 3986), so detect absolute URLs on a
 	// lowercased copy — otherwise HTTPS://... would be mis-treated as a
@@ -884,6 +1270,10 @@ func (c *Client) assertCredentialOrigin(req *http.Request) error {
 }
 
 func (c *Client) backoffDelay(attempt int) time.Duration {
+	if c.httpOpts.Jitter != nil {
+		return c.httpOpts.Jitter.Jitter(c.httpOpts.BaseDelay, attempt)
+	}
+
 	// Exponential backoff: base * 2^(attempt-1)
 	delay := c.httpOpts.BaseDelay * time.Duration(1<<(attempt-1))
 
@@ -895,23 +1285,52 @@ func (c *Client) backoffDelay(attempt int) time.Duration {
 
 // parseNextLink extracts the next URL from a Link header.
 func parseNextLink(linkHeader string) string {
-	if linkHeader == "" {
-		return ""
-	}
+	return ParseLinkHeader(linkHeader)["next"]
+}
+
+// ParseLinkHeader parses an RFC 8288 Link header into a map of relation type
+// to target URL, e.g. {"next": "https://...?page=2", "last": "..."}.
+// Relation types are lowercased; when a relation appears more than once the
+// first target wins. Targets are returned exactly as sent, so relative URLs
+// must be resolved by the caller. An empty or malformed header yields an
+// empty map.
+func ParseLinkHeader(header string) map[string]string {
+	links := make(map[string]string)
+	rest := header
+	for {
+		start := strings.IndexByte(rest, '<')
+		if start < 0 {
+			break
+		}
+		end := strings.IndexByte(rest[start:], '>')
+		if end < 0 {
+			break
+		}
+		target := rest[start+1 : start+end]
+		rest = rest[start+end+1:]
 
-	for part := range strings.SplitSeq(linkHeader, ",") {
-		part = strings.TrimSpace(part)
-		if strings.Contains(part, `rel="next"`) {
-			// Extract URL between < and >
-			start := strings.Index(part, "<")
-			end := strings.Index(part, ">")
-			if start >= 0 && end > start {
-				return part[start+1 : end]
+		// Parameters run until the next link value.
+		params := rest
+		if next := strings.IndexByte(rest, '<'); next >= 0 {
+			params = rest[:next]
+		}
+		for param := range strings.SplitSeq(params, ";") {
+			key, value, ok := strings.Cut(strings.TrimSpace(param), "=")
+			if !ok || !strings.EqualFold(strings.TrimSpace(key), "rel") {
+				continue
+			}
+			value = strings.TrimSpace(value)
+			value = strings.TrimRight(value, ", ")
+			value = strings.Trim(value, `"`)
+			for rel := range strings.FieldsSeq(value) {
+				rel = strings.ToLower(rel)
+				if _, exists := links[rel]; !exists {
+					links[rel] = target
+				}
 			}
 		}
 	}
-
-	return ""
+	return links
 }
 
 // parseRetryAfter parses the Retry-After header value.
@@ -943,6 +1362,12 @@ func (c *Client) Config() Config {
 	return *c.cfg
 }
 
+// ConcurrencyLimit returns the maximum number of requests the client keeps in
+// flight at once, or 0 when unlimited (see WithConcurrencyLimit).
+func (c *Client) ConcurrencyLimit() int {
+	return cap(c.requestSlots)
+}
+
 // Authorization returns the AuthorizationService for authorization operations.
 // This is the only service available directly on Client, as it doesn't require
 // an account context. All other services require an AccountClient via ForAccount.
@@ -101,6 +101,7 @@ func (s *ClientApprovalsService) List(ctx context.Context, opts *ClientApprovalL
 	op := OperationInfo{
 		Service: "ClientApprovals", Operation: "List",
 		ResourceType: "client_approval", IsMutation: false,
+		AccountID: s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -128,6 +129,7 @@ func (s *ClientApprovalsService) List(ctx context.Context, opts *ClientApprovalL
 
 	// Capture total count from X-Total-Count header
 	totalCount := parseTotalCount(resp.HTTPResponse)
+	totalPages := parseTotalPages(resp.HTTPResponse, firstPageLen(resp.JSON200))
 
 	// Parse first page
 	var approvals []ClientApproval
@@ -139,7 +141,7 @@ func (s *ClientApprovalsService) List(ctx context.Context, opts *ClientApprovalL
 
 	// Handle single page fetch (--page flag)
 	if opts != nil && opts.Page > 0 {
-		return &ClientApprovalListResult{Approvals: approvals, Meta: ListMeta{TotalCount: totalCount}}, nil
+		return &ClientApprovalListResult{Approvals: approvals, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages}}, nil
 	}
 
 	// Determine limit: 0 = all (no limit)
@@ -154,7 +156,7 @@ func (s *ClientApprovalsService) List(ctx context.Context, opts *ClientApprovalL
 
 	// Check if we already have enough items
 	if limit > 0 && len(approvals) >= limit {
-		return &ClientApprovalListResult{Approvals: approvals[:limit], Meta: ListMeta{TotalCount: totalCount, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(approvals), limit)}}, nil
+		return &ClientApprovalListResult{Approvals: approvals[:limit], Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(approvals), limit)}}, nil
 	}
 
 	// Follow pagination via Link headers
@@ -172,7 +174,7 @@ func (s *ClientApprovalsService) List(ctx context.Context, opts *ClientApprovalL
 		approvals = append(approvals, clientApprovalFromGenerated(ga))
 	}
 
-	return &ClientApprovalListResult{Approvals: approvals, Meta: ListMeta{TotalCount: totalCount, Truncated: truncated}}, nil
+	return &ClientApprovalListResult{Approvals: approvals, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: truncated}}, nil
 }
 
 // Get returns a client approval by ID.
@@ -181,6 +183,7 @@ func (s *ClientApprovalsService) Get(ctx context.Context, approvalID int64) (res
 		Service: "ClientApprovals", Operation: "Get",
 		ResourceType: "client_approval", IsMutation: false,
 		ResourceID: approvalID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -78,6 +78,7 @@ func (s *ClientCorrespondencesService) List(ctx context.Context, opts *ClientCor
 	op := OperationInfo{
 		Service: "ClientCorrespondences", Operation: "List",
 		ResourceType: "client_correspondence", IsMutation: false,
+		AccountID: s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -105,6 +106,7 @@ func (s *ClientCorrespondencesService) List(ctx context.Context, opts *ClientCor
 
 	// Capture total count from X-Total-Count header
 	totalCount := parseTotalCount(resp.HTTPResponse)
+	totalPages := parseTotalPages(resp.HTTPResponse, firstPageLen(resp.JSON200))
 
 	// Parse first page
 	var correspondences []ClientCorrespondence
@@ -116,7 +118,7 @@ func (s *ClientCorrespondencesService) List(ctx context.Context, opts *ClientCor
 
 	// Handle single page fetch (--page flag)
 	if opts != nil && opts.Page > 0 {
-		return &ClientCorrespondenceListResult{Correspondences: correspondences, Meta: ListMeta{TotalCount: totalCount}}, nil
+		return &ClientCorrespondenceListResult{Correspondences: correspondences, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages}}, nil
 	}
 
 	// Determine limit: 0 = all (no limit)
@@ -131,7 +133,7 @@ func (s *ClientCorrespondencesService) List(ctx context.Context, opts *ClientCor
 
 	// Check if we already have enough items
 	if limit > 0 && len(correspondences) >= limit {
-		return &ClientCorrespondenceListResult{Correspondences: correspondences[:limit], Meta: ListMeta{TotalCount: totalCount, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(correspondences), limit)}}, nil
+		return &ClientCorrespondenceListResult{Correspondences: correspondences[:limit], Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(correspondences), limit)}}, nil
 	}
 
 	// Follow pagination via Link headers
@@ -149,7 +151,7 @@ func (s *ClientCorrespondencesService) List(ctx context.Context, opts *ClientCor
 		correspondences = append(correspondences, clientCorrespondenceFromGenerated(gc))
 	}
 
-	return &ClientCorrespondenceListResult{Correspondences: correspondences, Meta: ListMeta{TotalCount: totalCount, Truncated: truncated}}, nil
+	return &ClientCorrespondenceListResult{Correspondences: correspondences, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: truncated}}, nil
 }
 
 // Get returns a client correspondence by ID.
@@ -158,6 +160,7 @@ func (s *ClientCorrespondencesService) Get(ctx context.Context, correspondenceID
 		Service: "ClientCorrespondences", Operation: "Get",
 		ResourceType: "client_correspondence", IsMutation: false,
 		ResourceID: correspondenceID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -69,6 +69,7 @@ func (s *ClientRepliesService) List(ctx context.Context, recordingID int64, opts
 		Service: "ClientReplies", Operation: "List",
 		ResourceType: "client_reply", IsMutation: false,
 		ResourceID: recordingID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -89,6 +90,7 @@ func (s *ClientRepliesService) List(ctx context.Context, recordingID int64, opts
 
 	// Capture total count from X-Total-Count header
 	totalCount := parseTotalCount(resp.HTTPResponse)
+	totalPages := parseTotalPages(resp.HTTPResponse, firstPageLen(resp.JSON200))
 
 	// Parse first page
 	var replies []ClientReply
@@ -100,7 +102,7 @@ func (s *ClientRepliesService) List(ctx context.Context, recordingID int64, opts
 
 	// Handle single page fetch (--page flag)
 	if opts != nil && opts.Page > 0 {
-		return &ClientReplyListResult{Replies: replies, Meta: ListMeta{TotalCount: totalCount}}, nil
+		return &ClientReplyListResult{Replies: replies, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages}}, nil
 	}
 
 	// Determine limit: 0 = all (no limit)
@@ -115,7 +117,7 @@ func (s *ClientRepliesService) List(ctx context.Context, recordingID int64, opts
 
 	// Check if we already have enough items
 	if limit > 0 && len(replies) >= limit {
-		return &ClientReplyListResult{Replies: replies[:limit], Meta: ListMeta{TotalCount: totalCount, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(replies), limit)}}, nil
+		return &ClientReplyListResult{Replies: replies[:limit], Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(replies), limit)}}, nil
 	}
 
 	// Follow pagination via Link headers
@@ -133,7 +135,7 @@ func (s *ClientRepliesService) List(ctx context.Context, recordingID int64, opts
 		replies = append(replies, clientReplyFromGenerated(gr))
 	}
 
-	return &ClientReplyListResult{Replies: replies, Meta: ListMeta{TotalCount: totalCount, Truncated: truncated}}, nil
+	return &ClientReplyListResult{Replies: replies, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: truncated}}, nil
 }
 
 // Get returns a specific client reply.
@@ -142,6 +144,7 @@ func (s *ClientRepliesService) Get(ctx context.Context, recordingID, replyID int
 		Service: "ClientReplies", Operation: "Get",
 		ResourceType: "client_reply", IsMutation: false,
 		ResourceID: replyID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -4,10 +4,16 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
+	"log/slog"
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 )
 
 type contentTypeAuthStrategy struct {
@@ -470,3 +476,655 @@ func TestSingleRequest_ErrorIncludesRequestID(t *testing.T) {
 		})
 	}
 }
+
+func TestSingleRequest_MaxResponseSize(t *testing.T) {
+	const limit = 64
+	var size int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`"` + strings.Repeat("a", size-2) + `"`))
+	}))
+	defer server.Close()
+
+	cfg := &Config{BaseURL: server.URL, CacheEnabled: false}
+	client := NewClient(cfg, &StaticTokenProvider{Token: "test-token"}, WithMaxResponseSize(limit))
+
+	size = limit
+	resp, err := client.Get(context.Background(), "/document.json")
+	if err != nil {
+		t.Fatalf("body at the limit should be read, got: %v", err)
+	}
+	if len(resp.Data) != limit {
+		t.Errorf("expected %d bytes, got %d", limit, len(resp.Data))
+	}
+
+	size = limit + 1
+	_, err = client.Get(context.Background(), "/document.json")
+	if err == nil {
+		t.Fatal("expected error for body over the limit")
+	}
+	for _, want := range []string{server.URL + "/document.json", "64 byte limit"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q should mention %q", err, want)
+		}
+	}
+}
+
+func TestSingleRequest_MaxErrorBodySize(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"error":"` + strings.Repeat("x", 100) + `"}`))
+	}))
+	defer server.Close()
+
+	cfg := &Config{BaseURL: server.URL, CacheEnabled: false}
+	client := NewClient(cfg, &StaticTokenProvider{Token: "test-token"}, WithMaxErrorBodySize(16))
+
+	_, err := client.Get(context.Background(), "/test.json")
+	apiErr, ok := errors.AsType[*Error](err)
+	if !ok {
+		t.Fatalf("expected *Error, got %v", err)
+	}
+	// The oversized body is discarded, so the generic message is used.
+	if apiErr.Message != "Request failed (HTTP 400)" {
+		t.Errorf("Message = %q, want generic message", apiErr.Message)
+	}
+}
+
+func TestNewClientWithError_InvalidBodyLimits(t *testing.T) {
+	cfg := &Config{BaseURL: "https://3.basecampapi.com"}
+	_, err := NewClientWithError(cfg, &StaticTokenProvider{Token: "t"},
+		WithMaxResponseSize(0), WithMaxErrorBodySize(-1))
+	if !errors.Is(err, ErrInvalidMaxResponseSize) {
+		t.Fatalf("expected ErrInvalidMaxResponseSize, got %v", err)
+	}
+	for _, want := range []string{"response body limit 0", "error body limit -1"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q should mention %q", err, want)
+		}
+	}
+}
+
+// captureHandler is a slog.Handler that records every log record it receives.
+type captureHandler struct {
+	mu      sync.Mutex
+	records []slog.Record
+}
+
+func (h *captureHandler) Enabled(context.Context, slog.Level) bool { return true }
+
+func (h *captureHandler) Handle(_ context.Context, r slog.Record) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.records = append(h.records, r.Clone())
+	return nil
+}
+
+func (h *captureHandler) WithAttrs([]slog.Attr) slog.Handler { return h }
+func (h *captureHandler) WithGroup(string) slog.Handler      { return h }
+
+// byMessage returns the attributes of every record with the given message
+// that carries the attribute key.
+func (h *captureHandler) byMessage(msg, key string) []map[string]slog.Value {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	var out []map[string]slog.Value
+	for _, r := range h.records {
+		if r.Message != msg {
+			continue
+		}
+		attrs := make(map[string]slog.Value)
+		r.Attrs(func(a slog.Attr) bool {
+			attrs[a.Key] = a.Value
+			return true
+		})
+		if _, ok := attrs[key]; ok {
+			out = append(out, attrs)
+		}
+	}
+	return out
+}
+
+func TestSingleRequest_LogsStructuredAttributes(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	}))
+	defer server.Close()
+
+	handler := &captureHandler{}
+	cfg := &Config{BaseURL: server.URL, CacheEnabled: false}
+	client := NewClient(cfg, &StaticTokenProvider{Token: "test-token"},
+		WithLogger(slog.New(handler)),
+		WithMaxRetries(3),
+		WithBaseDelay(time.Millisecond),
+		WithMaxJitter(time.Millisecond),
+	)
+
+	if _, err := client.Get(context.Background(), "/test.json"); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+
+	wantURL := server.URL + "/test.json"
+
+	// The logging transport emits its own "http request"/"http response"
+	// records; select the singleRequest ones by their attempt attribute.
+	requests := handler.byMessage("http request", "attempt")
+	if len(requests) != 3 {
+		t.Fatalf("got %d http request records, want 3", len(requests))
+	}
+	responses := handler.byMessage("http response", "attempt")
+	if len(responses) != 3 {
+		t.Fatalf("got %d http response records, want 3", len(responses))
+	}
+	for i := range 3 {
+		attempt := int64(i + 1)
+		for _, attrs := range []map[string]slog.Value{requests[i], responses[i]} {
+			if got := attrs["method"].String(); got != "GET" {
+				t.Errorf("attempt %d: method = %q, want GET", attempt, got)
+			}
+			if got := attrs["url"].String(); got != wantURL {
+				t.Errorf("attempt %d: url = %q, want %q", attempt, got, wantURL)
+			}
+			if got := attrs["attempt"]; got.Kind() != slog.KindInt64 || got.Int64() != attempt {
+				t.Errorf("attempt %d: attempt = %v, want %d", attempt, got, attempt)
+			}
+		}
+		wantStatus := int64(http.StatusServiceUnavailable)
+		if i == 2 {
+			wantStatus = http.StatusOK
+		}
+		if got := responses[i]["status"]; got.Kind() != slog.KindInt64 || got.Int64() != wantStatus {
+			t.Errorf("attempt %d: status = %v, want %d", attempt, got, wantStatus)
+		}
+		if got := responses[i]["latency"]; got.Kind() != slog.KindDuration {
+			t.Errorf("attempt %d: latency kind = %v, want Duration", attempt, got.Kind())
+		}
+	}
+
+	retries := handler.byMessage("retrying request", "attempt")
+	if len(retries) != 2 {
+		t.Fatalf("got %d retrying request records, want 2", len(retries))
+	}
+	for i, attrs := range retries {
+		if got := attrs["attempt"].Int64(); got != int64(i+1) {
+			t.Errorf("retry %d: attempt = %d, want %d", i, got, i+1)
+		}
+		if got := attrs["delay"]; got.Kind() != slog.KindDuration {
+			t.Errorf("retry %d: delay kind = %v, want Duration", i, got.Kind())
+		}
+		if _, ok := attrs["error"].Any().(error); !ok {
+			t.Errorf("retry %d: error = %v, want an error value", i, attrs["error"])
+		}
+	}
+}
+
+func TestWithRequestEditor_HeadersOnEveryAttempt(t *testing.T) {
+	var mu sync.Mutex
+	var seen []string
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		seen = append(seen, r.Header.Get("X-Correlation-ID")+"|"+r.Header.Get("X-Audit"))
+		mu.Unlock()
+		if calls.Add(1)%2 == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":1,"name":"Project"}`))
+	}))
+	defer server.Close()
+
+	var sawAuth string
+	client := NewClient(&Config{BaseURL: server.URL}, &StaticTokenProvider{Token: "test-token"},
+		WithBaseDelay(time.Millisecond), WithMaxJitter(time.Millisecond),
+		WithRequestEditor(func(ctx context.Context, req *http.Request) error {
+			sawAuth = req.Header.Get("Authorization")
+			req.Header.Set("X-Correlation-ID", "corr-1")
+			return nil
+		}),
+		WithRequestEditor(func(ctx context.Context, req *http.Request) error {
+			// Editors run in order, so the first editor's header is visible.
+			req.Header.Set("X-Audit", "after-"+req.Header.Get("X-Correlation-ID"))
+			return nil
+		}),
+	)
+
+	// Client.Get goes through singleRequest's retry loop.
+	if _, err := client.Get(context.Background(), "/projects/1.json"); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	// Service methods go through the generated client's retry loop.
+	if _, err := client.ForAccount("99999").Projects().Get(context.Background(), 1); err != nil {
+		t.Fatalf("Projects().Get() error = %v", err)
+	}
+
+	if sawAuth != "Bearer test-token" {
+		t.Errorf("editor saw Authorization %q, want it set before editors run", sawAuth)
+	}
+	if len(seen) != 4 {
+		t.Fatalf("requests = %d, want 4 (two attempts per call)", len(seen))
+	}
+	for i, got := range seen {
+		if got != "corr-1|after-corr-1" {
+			t.Errorf("attempt %d headers = %q, want %q", i+1, got, "corr-1|after-corr-1")
+		}
+	}
+}
+
+func TestWithRequestEditor_ErrorAbortsRequest(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+	}))
+	defer server.Close()
+
+	editorErr := errors.New("no correlation ID")
+	client := NewClient(&Config{BaseURL: server.URL}, &StaticTokenProvider{Token: "test-token"},
+		WithRequestEditor(func(ctx context.Context, req *http.Request) error {
+			return editorErr
+		}),
+	)
+
+	if _, err := client.Get(context.Background(), "/projects.json"); !errors.Is(err, editorErr) {
+		t.Errorf("Get() error = %v, want %v", err, editorErr)
+	}
+	if _, err := client.ForAccount("99999").Projects().Get(context.Background(), 1); !errors.Is(err, editorErr) {
+		t.Errorf("Projects().Get() error = %v, want %v", err, editorErr)
+	}
+	if calls.Load() != 0 {
+		t.Errorf("calls = %d, want 0", calls.Load())
+	}
+}
+
+type hostRecordingTransport struct {
+	hosts []string
+}
+
+func (t *hostRecordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.hosts = append(t.hosts, req.URL.Scheme+"://"+req.URL.Host)
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(`{}`)),
+		Request:    req,
+	}, nil
+}
+
+func TestWithBaseURL_OverridesConfig(t *testing.T) {
+	transport := &hostRecordingTransport{}
+	cfg := DefaultConfig()
+	client := NewClient(cfg, &StaticTokenProvider{Token: "test-token"},
+		WithBaseURL("https://custom.api.example.com/"),
+		WithTransport(transport),
+	)
+
+	if _, err := client.ForAccount("12345").Get(context.Background(), "/projects.json"); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if len(transport.hosts) != 1 || transport.hosts[0] != "https://custom.api.example.com" {
+		t.Errorf("requests went to %v, want https://custom.api.example.com", transport.hosts)
+	}
+	if got := client.Config().BaseURL; got != "https://custom.api.example.com" {
+		t.Errorf("Config().BaseURL = %q, want %q", got, "https://custom.api.example.com")
+	}
+	if cfg.BaseURL == "https://custom.api.example.com" {
+		t.Error("WithBaseURL mutated the caller's Config")
+	}
+}
+
+func TestWithBaseURL_Insecure(t *testing.T) {
+	_, err := NewClientWithError(DefaultConfig(), &StaticTokenProvider{Token: "test-token"}, WithBaseURL("http://evil.com"))
+	if !errors.Is(err, ErrInsecureBaseURL) {
+		t.Errorf("NewClientWithError error = %v, want ErrInsecureBaseURL", err)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("NewClient with WithBaseURL(\"http://evil.com\") did not panic")
+		}
+	}()
+	NewClient(DefaultConfig(), &StaticTokenProvider{Token: "test-token"}, WithBaseURL("http://evil.com"))
+}
+
+func TestWithBaseURL_LocalhostHTTP(t *testing.T) {
+	if _, err := NewClientWithError(DefaultConfig(), &StaticTokenProvider{Token: "test-token"}, WithBaseURL("http://localhost:3000")); err != nil {
+		t.Errorf("NewClientWithError with localhost HTTP URL: %v", err)
+	}
+}
+
+func TestWithBaseURL_Empty(t *testing.T) {
+	_, err := NewClientWithError(DefaultConfig(), &StaticTokenProvider{Token: "test-token"}, WithBaseURL(""))
+	if !errors.Is(err, ErrEmptyBaseURL) {
+		t.Errorf("NewClientWithError with WithBaseURL(\"\") error = %v, want ErrEmptyBaseURL", err)
+	}
+}
+
+// slowHandler sleeps for d before responding, returning early if the client
+// gives up on the request.
+func slowHandler(w http.ResponseWriter, r *http.Request, d time.Duration) {
+	select {
+	case <-time.After(d):
+	case <-r.Context().Done():
+	}
+}
+
+func TestWithRequestTimeout_CancelsSlowAttempt(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		slowHandler(w, r, 200*time.Millisecond)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[]`)
+	}))
+	t.Cleanup(server.Close)
+
+	cfg := DefaultConfig()
+	cfg.BaseURL = server.URL
+	client := NewClient(cfg, &StaticTokenProvider{Token: "test-token"},
+		WithRequestTimeout(50*time.Millisecond),
+		WithMaxRetries(1),
+		WithBaseDelay(time.Millisecond),
+		WithMaxJitter(time.Millisecond),
+	)
+
+	start := time.Now()
+	_, err := client.ForAccount("99999").Get(context.Background(), "/projects.json")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed >= 200*time.Millisecond {
+		t.Errorf("request took %v, want it cut off near 50ms", elapsed)
+	}
+}
+
+func TestWithRequestTimeout_PaginationRetriesNextPage(t *testing.T) {
+	var page2Attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "2" {
+			if page2Attempts.Add(1) == 1 {
+				slowHandler(w, r, 200*time.Millisecond)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `[{"id":2}]`)
+			return
+		}
+		w.Header().Set("Link", fmt.Sprintf(`<http://%s/99999/projects.json?page=2>; rel="next"`, r.Host))
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{"id":1}]`)
+	}))
+	t.Cleanup(server.Close)
+
+	cfg := DefaultConfig()
+	cfg.BaseURL = server.URL
+	client := NewClient(cfg, &StaticTokenProvider{Token: "test-token"},
+		WithRequestTimeout(50*time.Millisecond),
+		WithBaseDelay(time.Millisecond),
+		WithMaxJitter(time.Millisecond),
+	)
+
+	items, err := client.ForAccount("99999").GetAll(context.Background(), "/projects.json")
+	if err != nil {
+		t.Fatalf("GetAll: %v", err)
+	}
+	if len(items) != 2 {
+		t.Errorf("expected 2 items, got %d", len(items))
+	}
+	if got := page2Attempts.Load(); got != 2 {
+		t.Errorf("expected 2 attempts at page 2, got %d", got)
+	}
+}
+
+func TestWithRequestTimeout_Negative(t *testing.T) {
+	_, err := NewClientWithError(DefaultConfig(), &StaticTokenProvider{Token: "test-token"}, WithRequestTimeout(-time.Second))
+	if !errors.Is(err, ErrInvalidTimeout) {
+		t.Fatalf("expected ErrInvalidTimeout, got %v", err)
+	}
+}
+
+func TestClient_StatusErrorCodes(t *testing.T) {
+	tests := []struct {
+		status   int
+		wantCode string
+	}{
+		{http.StatusConflict, CodeConflict},
+		{http.StatusRequestEntityTooLarge, CodePayloadTooLarge},
+		{http.StatusUnprocessableEntity, CodeValidation},
+		{http.StatusTeapot, CodeAPI},
+	}
+
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.status), func(t *testing.T) {
+			var requests atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests.Add(1)
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.status)
+				fmt.Fprint(w, `{"error":"rejected"}`)
+			}))
+			defer server.Close()
+
+			cfg := DefaultConfig()
+			cfg.BaseURL = server.URL
+			client := NewClient(cfg, &StaticTokenProvider{Token: "test-token"})
+
+			_, err := client.Get(context.Background(), "/projects.json")
+			e, ok := AsAPIError(err)
+			if !ok {
+				t.Fatalf("expected *Error, got %v", err)
+			}
+			if e.Code != tt.wantCode || e.HTTPStatus != tt.status {
+				t.Errorf("error = (%q, %d), want (%q, %d)", e.Code, e.HTTPStatus, tt.wantCode, tt.status)
+			}
+			if e.Retryable {
+				t.Error("expected non-retryable error")
+			}
+			if e.Message != "rejected" {
+				t.Errorf("Message = %q, want server message", e.Message)
+			}
+			if got := requests.Load(); got != 1 {
+				t.Errorf("expected 1 request, got %d", got)
+			}
+		})
+	}
+}
+
+func TestClient_Clone(t *testing.T) {
+	var userAgents sync.Map
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userAgents.Store(r.Header.Get("User-Agent"), true)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[]`)
+	}))
+	defer server.Close()
+
+	cfg := DefaultConfig()
+	cfg.BaseURL = server.URL
+	originalLogs := &captureHandler{}
+	original := NewClient(cfg, &StaticTokenProvider{Token: "test-token"},
+		WithUserAgent("original-agent"), WithLogger(slog.New(originalLogs)))
+
+	handler := &captureHandler{}
+	clone := original.Clone(WithLogger(slog.New(handler)))
+	if clone == original || clone.httpClient == original.httpClient {
+		t.Fatal("expected clone to have its own client and HTTP client")
+	}
+	if clone.cfg == original.cfg || *clone.cfg != *original.cfg {
+		t.Errorf("expected clone to have an equal copy of the config")
+	}
+	if clone.userAgent != "original-agent" {
+		t.Errorf("clone userAgent = %q, want original option replayed", clone.userAgent)
+	}
+
+	if _, err := clone.ForAccount("99999").Projects().List(context.Background(), nil); err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(handler.byMessage("http request", "url")) == 0 {
+		t.Error("expected clone's logger to receive request logs")
+	}
+	if n := len(originalLogs.byMessage("http request", "url")); n != 0 {
+		t.Errorf("original's logger received %d request logs from the clone", n)
+	}
+
+	var wg sync.WaitGroup
+	for _, c := range []*Client{original, clone, original, clone} {
+		wg.Go(func() {
+			if _, err := c.ForAccount("99999").Projects().List(context.Background(), nil); err != nil {
+				t.Errorf("List() error = %v", err)
+			}
+		})
+	}
+	wg.Wait()
+
+	if clone.gen == nil || clone.gen == original.gen {
+		t.Error("expected clone to have its own generated client")
+	}
+	if _, ok := userAgents.Load("original-agent"); !ok {
+		t.Error("expected requests to carry the replayed User-Agent")
+	}
+}
+
+func TestClient_Clone_DoesNotAffectOriginal(t *testing.T) {
+	original := NewClient(DefaultConfig(), &StaticTokenProvider{Token: "test-token"},
+		WithLogger(slog.New(&captureHandler{})), WithMaxRetries(2))
+
+	clone := original.Clone(WithMaxRetries(5), WithUserAgent("tenant-agent"))
+
+	if clone.httpOpts.MaxRetries != 5 {
+		t.Errorf("clone MaxRetries = %d, want 5", clone.httpOpts.MaxRetries)
+	}
+	if original.httpOpts.MaxRetries != 2 || original.userAgent != DefaultUserAgent {
+		t.Errorf("original changed: MaxRetries = %d, userAgent = %q", original.httpOpts.MaxRetries, original.userAgent)
+	}
+	if clone.logger != original.logger {
+		t.Error("expected clone to keep the original's logger")
+	}
+
+	again := clone.Clone()
+	if again.httpOpts.MaxRetries != 5 || again.userAgent != "tenant-agent" {
+		t.Errorf("clone of clone = (%d, %q), want (5, tenant-agent)", again.httpOpts.MaxRetries, again.userAgent)
+	}
+	if len(original.opts) != 2 {
+		t.Errorf("original options = %d, want 2", len(original.opts))
+	}
+}
+
+func TestWithConcurrencyLimit(t *testing.T) {
+	var inFlight, peak atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{}`)
+	}))
+	defer server.Close()
+
+	cfg := DefaultConfig()
+	cfg.BaseURL = server.URL
+	client := NewClient(cfg, &StaticTokenProvider{Token: "test-token"}, WithConcurrencyLimit(2))
+	if got := client.ConcurrencyLimit(); got != 2 {
+		t.Fatalf("ConcurrencyLimit() = %d, want 2", got)
+	}
+
+	var wg sync.WaitGroup
+	for range 5 {
+		wg.Go(func() {
+			if _, err := client.Get(context.Background(), "/99999/projects/1.json"); err != nil {
+				t.Errorf("Get() error = %v", err)
+			}
+		})
+	}
+	wg.Wait()
+
+	if got := peak.Load(); got != 2 {
+		t.Errorf("peak in-flight requests = %d, want 2", got)
+	}
+}
+
+func TestWithConcurrencyLimit_SharedWithTimeoutAccountClient(t *testing.T) {
+	var inFlight, peak atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{}`)
+	}))
+	defer server.Close()
+
+	cfg := DefaultConfig()
+	cfg.BaseURL = server.URL
+	client := NewClient(cfg, &StaticTokenProvider{Token: "test-token"}, WithConcurrencyLimit(1))
+	account := client.ForAccount("99999")
+	short := account.WithTimeout(time.Second)
+	if got := short.Parent().ConcurrencyLimit(); got != 1 {
+		t.Fatalf("Parent().ConcurrencyLimit() = %d, want 1", got)
+	}
+
+	var wg sync.WaitGroup
+	for _, ac := range []*AccountClient{short, short, account, account} {
+		wg.Go(func() {
+			if _, err := ac.Get(context.Background(), "/projects/1.json"); err != nil {
+				t.Errorf("Get() error = %v", err)
+			}
+		})
+	}
+	wg.Wait()
+
+	if got := peak.Load(); got != 1 {
+		t.Errorf("peak in-flight requests = %d, want 1", got)
+	}
+}
+
+func TestWithConcurrencyLimit_ContextCanceledWhileWaiting(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{}`)
+	}))
+	defer server.Close()
+	defer close(release)
+
+	cfg := DefaultConfig()
+	cfg.BaseURL = server.URL
+	client := NewClient(cfg, &StaticTokenProvider{Token: "test-token"}, WithConcurrencyLimit(1))
+
+	go func() { _, _ = client.Get(context.Background(), "/99999/projects/1.json") }()
+	for len(client.requestSlots) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := client.Get(ctx, "/99999/projects/2.json"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Get() error = %v, want context.DeadlineExceeded", err)
+	}
+}
+
+func TestConcurrencyLimit_DefaultUnlimited(t *testing.T) {
+	client := NewClient(DefaultConfig(), &StaticTokenProvider{Token: "test-token"})
+	if got := client.ConcurrencyLimit(); got != 0 {
+		t.Errorf("ConcurrencyLimit() = %d, want 0", got)
+	}
+	if got := client.Clone(WithConcurrencyLimit(3)).ConcurrencyLimit(); got != 3 {
+		t.Errorf("clone ConcurrencyLimit() = %d, want 3", got)
+	}
+}
@@ -90,6 +90,7 @@ func (s *CommentsService) List(ctx context.Context, recordingID int64, opts *Com
 		Service: "Comments", Operation: "List",
 		ResourceType: "comment", IsMutation: false,
 		ResourceID: recordingID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -111,6 +112,7 @@ func (s *CommentsService) List(ctx context.Context, recordingID int64, opts *Com
 
 	// Capture total count from X-Total-Count header (first page only)
 	totalCount := parseTotalCount(resp.HTTPResponse)
+	totalPages := parseTotalPages(resp.HTTPResponse, firstPageLen(resp.JSON200))
 
 	// Parse first page
 	var comments []Comment
@@ -122,7 +124,7 @@ func (s *CommentsService) List(ctx context.Context, recordingID int64, opts *Com
 
 	// Handle single page fetch (--page flag)
 	if opts != nil && opts.Page > 0 {
-		return &CommentListResult{Comments: comments, Meta: ListMeta{TotalCount: totalCount}}, nil
+		return &CommentListResult{Comments: comments, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages}}, nil
 	}
 
 	// Determine limit: 0 = default (100), -1 = unlimited, >0 = specific limit
@@ -137,7 +139,7 @@ func (s *CommentsService) List(ctx context.Context, recordingID int64, opts *Com
 
 	// Check if we already have enough items
 	if limit > 0 && len(comments) >= limit {
-		return &CommentListResult{Comments: comments[:limit], Meta: ListMeta{TotalCount: totalCount, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(comments), limit)}}, nil
+		return &CommentListResult{Comments: comments[:limit], Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(comments), limit)}}, nil
 	}
 
 	// Follow pagination via Link headers (uses absolute URLs from API, no path construction)
@@ -155,7 +157,18 @@ func (s *CommentsService) List(ctx context.Context, recordingID int64, opts *Com
 		comments = append(comments, commentFromGenerated(gc))
 	}
 
-	return &CommentListResult{Comments: comments, Meta: ListMeta{TotalCount: totalCount, Truncated: truncated}}, nil
+	return &CommentListResult{Comments: comments, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: truncated}}, nil
+}
+
+// ListAll returns every comment on a recording, following Link headers
+// across pages (subject to the client's MaxPages cap). It is List with
+// Limit: -1, so hooks observe the Comments.List operation.
+func (s *CommentsService) ListAll(ctx context.Context, recordingID int64) ([]Comment, error) {
+	result, err := s.List(ctx, recordingID, &CommentListOptions{Limit: -1})
+	if err != nil {
+		return nil, err
+	}
+	return result.Comments, nil
 }
 
 // Get returns a comment by ID.
@@ -164,6 +177,7 @@ func (s *CommentsService) Get(ctx context.Context, commentID int64) (result *Com
 		Service: "Comments", Operation: "Get",
 		ResourceType: "comment", IsMutation: false,
 		ResourceID: commentID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -197,6 +211,7 @@ func (s *CommentsService) Create(ctx context.Context, recordingID int64, req *Cr
 		Service: "Comments", Operation: "Create",
 		ResourceType: "comment", IsMutation: true,
 		ResourceID: recordingID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -239,6 +254,7 @@ func (s *CommentsService) Update(ctx context.Context, commentID int64, req *Upda
 		Service: "Comments", Operation: "Update",
 		ResourceType: "comment", IsMutation: true,
 		ResourceID: commentID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -281,6 +297,7 @@ func (s *CommentsService) Trash(ctx context.Context, commentID int64) (err error
 		Service: "Comments", Operation: "Trash",
 		ResourceType: "comment", IsMutation: true,
 		ResourceID: commentID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
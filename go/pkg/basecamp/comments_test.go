@@ -1,7 +1,11 @@
 package basecamp
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"testing"
@@ -250,3 +254,85 @@ func TestComment_UnmarshalCreate(t *testing.T) {
 		t.Errorf("expected Parent.ID 1069479351, got %d", comment.Parent.ID)
 	}
 }
+
+// testCommentsServer creates an httptest.Server and a CommentsService wired to it.
+func testCommentsServer(t *testing.T, handler http.HandlerFunc) *CommentsService {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	cfg := DefaultConfig()
+	cfg.BaseURL = server.URL
+	client := NewClient(cfg, &StaticTokenProvider{Token: "test-token"})
+	return client.ForAccount("99999").Comments()
+}
+
+func TestCommentsService_ListAll(t *testing.T) {
+	var requests int
+	svc := testCommentsServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		page := r.URL.Query().Get("page")
+		if page == "" {
+			page = "1"
+		}
+		if page != "3" {
+			next := map[string]string{"1": "2", "2": "3"}[page]
+			w.Header().Set("Link", fmt.Sprintf(`<http://%s/99999/recordings/100/comments.json?page=%s>; rel="next"`, r.Host, next))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `[{"id":%s01,"content":"page %s"},{"id":%s02,"content":"page %s"}]`, page, page, page, page)
+	})
+
+	comments, err := svc.ListAll(context.Background(), 100)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if requests != 3 {
+		t.Errorf("expected 3 requests, got %d", requests)
+	}
+	if len(comments) != 6 {
+		t.Fatalf("expected 6 comments, got %d", len(comments))
+	}
+	if comments[0].ID != 101 || comments[5].ID != 302 {
+		t.Errorf("unexpected comment IDs: first %d, last %d", comments[0].ID, comments[5].ID)
+	}
+}
+
+func TestCommentsService_Update(t *testing.T) {
+	fixture := loadCommentsFixture(t, "get.json")
+	var receivedMethod, receivedPath string
+	var receivedBody map[string]any
+	svc := testCommentsServer(t, func(w http.ResponseWriter, r *http.Request) {
+		receivedMethod = r.Method
+		receivedPath = r.URL.Path
+		receivedBody = decodeRequestBody(t, r)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(fixture)
+	})
+
+	if _, err := svc.Update(context.Background(), 12345, &UpdateCommentRequest{Content: "<div>Edited</div>"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if receivedMethod != "PUT" || receivedPath != "/99999/comments/12345" {
+		t.Errorf("expected PUT /99999/comments/12345, got %s %s", receivedMethod, receivedPath)
+	}
+	if receivedBody["content"] != "<div>Edited</div>" {
+		t.Errorf("expected content in body, got %v", receivedBody)
+	}
+}
+
+func TestCommentsService_Trash(t *testing.T) {
+	var receivedMethod, receivedPath string
+	svc := testCommentsServer(t, func(w http.ResponseWriter, r *http.Request) {
+		receivedMethod = r.Method
+		receivedPath = r.URL.Path
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if err := svc.Trash(context.Background(), 12345); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if receivedMethod != "PUT" || receivedPath != "/99999/recordings/12345/status/trashed.json" {
+		t.Errorf("expected PUT /99999/recordings/12345/status/trashed.json, got %s %s", receivedMethod, receivedPath)
+	}
+}
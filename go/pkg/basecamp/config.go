@@ -2,10 +2,13 @@ package basecamp
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"time"
 )
 
 // Config holds the resolved configuration for API access.
@@ -24,6 +27,15 @@ type Config struct {
 
 	// CacheEnabled controls whether HTTP caching is enabled.
 	CacheEnabled bool `json:"cache_enabled"`
+
+	// MaxRetries, Timeout, MaxPages, and BaseDelay seed the client's
+	// HTTPOptions. Zero leaves the default in place, and ClientOptions such as
+	// WithMaxRetries take precedence. They are usually set by
+	// LoadConfigFromEnv.
+	MaxRetries int           `json:"-"`
+	Timeout    time.Duration `json:"-"`
+	MaxPages   int           `json:"-"`
+	BaseDelay  time.Duration `json:"-"`
 }
 
 // DefaultConfig returns a Config with sensible defaults.
@@ -62,7 +74,16 @@ func LoadConfig(path string) (*Config, error) {
 
 // LoadConfigFromEnv loads configuration from environment variables.
 // Environment variables override any values already set in the config.
-func (c *Config) LoadConfigFromEnv() {
+//
+// It reads BASECAMP_BASE_URL, BASECAMP_PROJECT_ID, BASECAMP_TODOLIST_ID,
+// BASECAMP_CACHE_DIR, and BASECAMP_CACHE_ENABLED (true, false, 1, or 0, in
+// any case). Besides these connection settings, it reads HTTP tuning variables:
+// BASECAMP_MAX_RETRIES (integer >= 1), BASECAMP_TIMEOUT_SECONDS (positive
+// number, fractions allowed), BASECAMP_MAX_PAGES (integer >= 1), and
+// BASECAMP_BASE_DELAY_MS (integer >= 0). Invalid values are skipped and
+// reported in the returned error, which joins every problem found; the
+// remaining variables are still applied.
+func (c *Config) LoadConfigFromEnv() error {
 	if v := os.Getenv("BASECAMP_BASE_URL"); v != "" {
 		c.BaseURL = v
 	}
@@ -75,9 +96,100 @@ func (c *Config) LoadConfigFromEnv() {
 	if v := os.Getenv("BASECAMP_CACHE_DIR"); v != "" {
 		c.CacheDir = v
 	}
+
+	var errs []error
 	if v := os.Getenv("BASECAMP_CACHE_ENABLED"); v != "" {
-		c.CacheEnabled = strings.ToLower(v) == "true" || v == "1"
+		switch strings.ToLower(v) {
+		case "true", "1":
+			c.CacheEnabled = true
+		case "false", "0":
+			c.CacheEnabled = false
+		default:
+			errs = append(errs, fmt.Errorf("cache enabled must be true, false, 1, or 0: BASECAMP_CACHE_ENABLED=%q", v))
+		}
+	}
+	if v := os.Getenv("BASECAMP_MAX_RETRIES"); v != "" {
+		if n, err := strconv.Atoi(v); err != nil || n < 1 {
+			errs = append(errs, fmt.Errorf("%w: BASECAMP_MAX_RETRIES=%q", ErrInvalidMaxRetries, v))
+		} else {
+			c.MaxRetries = n
+		}
+	}
+	if v := os.Getenv("BASECAMP_TIMEOUT_SECONDS"); v != "" {
+		if secs, err := strconv.ParseFloat(v, 64); err != nil || secs <= 0 || secs > maxEnvTimeoutSeconds {
+			errs = append(errs, fmt.Errorf("%w: BASECAMP_TIMEOUT_SECONDS=%q", ErrInvalidTimeout, v))
+		} else {
+			c.Timeout = time.Duration(secs * float64(time.Second))
+		}
+	}
+	if v := os.Getenv("BASECAMP_MAX_PAGES"); v != "" {
+		if n, err := strconv.Atoi(v); err != nil || n < 1 {
+			errs = append(errs, fmt.Errorf("%w: BASECAMP_MAX_PAGES=%q", ErrInvalidMaxPages, v))
+		} else {
+			c.MaxPages = n
+		}
+	}
+	if v := os.Getenv("BASECAMP_BASE_DELAY_MS"); v != "" {
+		if ms, err := strconv.Atoi(v); err != nil || ms < 0 {
+			errs = append(errs, fmt.Errorf("base delay must be a non-negative integer of milliseconds: BASECAMP_BASE_DELAY_MS=%q", v))
+		} else {
+			c.BaseDelay = time.Duration(ms) * time.Millisecond
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// maxEnvTimeoutSeconds bounds BASECAMP_TIMEOUT_SECONDS so the conversion to
+// time.Duration cannot overflow.
+const maxEnvTimeoutSeconds = 24 * 60 * 60
+
+// Validate reports configuration problems that would make NewClient panic.
+// Localhost base URLs may use plain HTTP for local development and tests.
+// The returned error joins every problem found (see errors.Join); use
+// errors.Is with ErrInsecureBaseURL to test for a specific one.
+func (c *Config) Validate() error {
+	var errs []error
+	if c.BaseURL != "" && !isLocalhost(c.BaseURL) {
+		if err := requireHTTPS(c.BaseURL); err != nil {
+			errs = append(errs, fmt.Errorf("%w: %s", ErrInsecureBaseURL, c.BaseURL))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// WithProjectID returns a copy of c with ProjectID set to id, leaving c
+// unchanged. It panics if id is not a positive integer.
+//
+// The With* methods chain for fluent initialization:
+//
+//	cfg := basecamp.DefaultConfig().WithProjectID("123").WithCacheEnabled(true)
+func (c *Config) WithProjectID(id string) *Config {
+	if !isNumeric(id) || strings.TrimLeft(id, "0") == "" {
+		panic("basecamp: Config.WithProjectID requires positive numeric ID, got: " + id)
+	}
+	cfg := *c
+	cfg.ProjectID = id
+	return &cfg
+}
+
+// WithBaseURL returns a copy of c with BaseURL set to url, leaving c
+// unchanged. It panics if url does not use HTTPS; localhost URLs may use
+// plain HTTP, as in Validate.
+func (c *Config) WithBaseURL(url string) *Config {
+	if !isLocalhost(url) && requireHTTPS(url) != nil {
+		panic(fmt.Sprintf("basecamp: Config.WithBaseURL: %v: %s", ErrInsecureBaseURL, url))
 	}
+	cfg := *c
+	cfg.BaseURL = url
+	return &cfg
+}
+
+// WithCacheEnabled returns a copy of c with CacheEnabled set to enabled,
+// leaving c unchanged.
+func (c *Config) WithCacheEnabled(enabled bool) *Config {
+	cfg := *c
+	cfg.CacheEnabled = enabled
+	return &cfg
 }
 
 // NormalizeBaseURL ensures consistent URL format (no trailing slash).
@@ -1,9 +1,12 @@
 package basecamp
 
 import (
+	"errors"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestDefaultConfig(t *testing.T) {
@@ -70,7 +73,9 @@ func TestConfig_LoadConfigFromEnv(t *testing.T) {
 	t.Setenv("BASECAMP_CACHE_ENABLED", "true")
 
 	cfg := DefaultConfig()
-	cfg.LoadConfigFromEnv()
+	if err := cfg.LoadConfigFromEnv(); err != nil {
+		t.Fatalf("LoadConfigFromEnv() error = %v", err)
+	}
 
 	if cfg.BaseURL != "https://env.example.com" {
 		t.Errorf("BaseURL = %q, want env value", cfg.BaseURL)
@@ -98,6 +103,7 @@ func TestConfig_LoadConfigFromEnv_CacheEnabled_Values(t *testing.T) {
 		{"TRUE", true},
 		{"1", true},
 		{"false", false},
+		{"False", false},
 		{"0", false},
 		{"", false},
 	}
@@ -108,7 +114,9 @@ func TestConfig_LoadConfigFromEnv_CacheEnabled_Values(t *testing.T) {
 			if tt.env != "" {
 				t.Setenv("BASECAMP_CACHE_ENABLED", tt.env)
 			}
-			cfg.LoadConfigFromEnv()
+			if err := cfg.LoadConfigFromEnv(); err != nil {
+				t.Fatalf("LoadConfigFromEnv() error = %v", err)
+			}
 			if cfg.CacheEnabled != tt.want {
 				t.Errorf("BASECAMP_CACHE_ENABLED=%q: CacheEnabled = %v, want %v", tt.env, cfg.CacheEnabled, tt.want)
 			}
@@ -116,6 +124,102 @@ func TestConfig_LoadConfigFromEnv_CacheEnabled_Values(t *testing.T) {
 	}
 }
 
+func TestConfig_LoadConfigFromEnv_CacheEnabledInvalid(t *testing.T) {
+	t.Setenv("BASECAMP_CACHE_ENABLED", "invalid")
+	t.Setenv("BASECAMP_CACHE_DIR", "/tmp/test-cache")
+
+	cfg := DefaultConfig()
+	cfg.CacheEnabled = true
+	err := cfg.LoadConfigFromEnv()
+	if err == nil || !strings.Contains(err.Error(), `BASECAMP_CACHE_ENABLED="invalid"`) {
+		t.Fatalf("LoadConfigFromEnv() error = %v, want one naming BASECAMP_CACHE_ENABLED", err)
+	}
+	if !cfg.CacheEnabled {
+		t.Error("CacheEnabled changed by an invalid value, want it left as is")
+	}
+	if cfg.CacheDir != "/tmp/test-cache" {
+		t.Errorf("CacheDir = %q, want other variables still applied", cfg.CacheDir)
+	}
+}
+
+func TestConfig_LoadConfigFromEnv_HTTPTuning(t *testing.T) {
+	t.Setenv("BASECAMP_MAX_RETRIES", "5")
+	t.Setenv("BASECAMP_TIMEOUT_SECONDS", "2.5")
+	t.Setenv("BASECAMP_MAX_PAGES", "20")
+	t.Setenv("BASECAMP_BASE_DELAY_MS", "250")
+
+	cfg := DefaultConfig()
+	if err := cfg.LoadConfigFromEnv(); err != nil {
+		t.Fatalf("LoadConfigFromEnv() error = %v", err)
+	}
+
+	if cfg.MaxRetries != 5 {
+		t.Errorf("MaxRetries = %d, want 5", cfg.MaxRetries)
+	}
+	if cfg.Timeout != 2500*time.Millisecond {
+		t.Errorf("Timeout = %v, want 2.5s", cfg.Timeout)
+	}
+	if cfg.MaxPages != 20 {
+		t.Errorf("MaxPages = %d, want 20", cfg.MaxPages)
+	}
+	if cfg.BaseDelay != 250*time.Millisecond {
+		t.Errorf("BaseDelay = %v, want 250ms", cfg.BaseDelay)
+	}
+
+	client := NewClient(cfg, &StaticTokenProvider{Token: "test-token"})
+	if client.httpOpts.MaxRetries != 5 {
+		t.Errorf("client MaxRetries = %d, want 5", client.httpOpts.MaxRetries)
+	}
+	if client.httpOpts.Timeout != 2500*time.Millisecond || client.httpClient.Timeout != 2500*time.Millisecond {
+		t.Errorf("client Timeout = %v / %v, want 2.5s", client.httpOpts.Timeout, client.httpClient.Timeout)
+	}
+	if client.httpOpts.MaxPages != 20 {
+		t.Errorf("client MaxPages = %d, want 20", client.httpOpts.MaxPages)
+	}
+	if client.httpOpts.BaseDelay != 250*time.Millisecond {
+		t.Errorf("client BaseDelay = %v, want 250ms", client.httpOpts.BaseDelay)
+	}
+
+	// Explicit options take precedence over config values.
+	client = NewClient(cfg, &StaticTokenProvider{Token: "test-token"}, WithMaxRetries(2))
+	if client.httpOpts.MaxRetries != 2 {
+		t.Errorf("WithMaxRetries(2): MaxRetries = %d, want 2", client.httpOpts.MaxRetries)
+	}
+}
+
+func TestConfig_LoadConfigFromEnv_HTTPTuningInvalid(t *testing.T) {
+	t.Setenv("BASECAMP_BASE_URL", "https://env.example.com")
+	t.Setenv("BASECAMP_MAX_RETRIES", "-1")
+	t.Setenv("BASECAMP_TIMEOUT_SECONDS", "soon")
+	t.Setenv("BASECAMP_MAX_PAGES", "0")
+	t.Setenv("BASECAMP_BASE_DELAY_MS", "1.5")
+
+	cfg := DefaultConfig()
+	err := cfg.LoadConfigFromEnv()
+	if err == nil {
+		t.Fatal("expected error for invalid values")
+	}
+
+	for _, sentinel := range []error{ErrInvalidMaxRetries, ErrInvalidTimeout, ErrInvalidMaxPages} {
+		if !errors.Is(err, sentinel) {
+			t.Errorf("error should wrap %v, got: %v", sentinel, err)
+		}
+	}
+	for _, name := range []string{"BASECAMP_MAX_RETRIES=\"-1\"", "BASECAMP_TIMEOUT_SECONDS=\"soon\"", "BASECAMP_MAX_PAGES=\"0\"", "BASECAMP_BASE_DELAY_MS=\"1.5\""} {
+		if !strings.Contains(err.Error(), name) {
+			t.Errorf("error should mention %s, got: %v", name, err)
+		}
+	}
+
+	// Invalid values are skipped; valid ones are still applied.
+	if cfg.MaxRetries != 0 || cfg.Timeout != 0 || cfg.MaxPages != 0 || cfg.BaseDelay != 0 {
+		t.Errorf("invalid values should not be applied: %+v", cfg)
+	}
+	if cfg.BaseURL != "https://env.example.com" {
+		t.Errorf("BaseURL = %q, want env value", cfg.BaseURL)
+	}
+}
+
 func TestNormalizeBaseURL(t *testing.T) {
 	tests := []struct {
 		input, want string
@@ -131,3 +235,138 @@ func TestNormalizeBaseURL(t *testing.T) {
 		}
 	}
 }
+
+func TestConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		baseURL string
+		wantErr bool
+	}{
+		{"default", "https://3.basecampapi.com", false},
+		{"empty", "", false},
+		{"localhost http", "http://127.0.0.1:8080", false},
+		{"remote http", "http://3.basecampapi.com", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := (&Config{BaseURL: tt.baseURL}).Validate()
+			if got := errors.Is(err, ErrInsecureBaseURL); got != tt.wantErr {
+				t.Errorf("Validate() = %v, want ErrInsecureBaseURL: %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestConfig_WithMethods(t *testing.T) {
+	base := DefaultConfig()
+	orig := *base
+
+	cfg := base.WithProjectID("123")
+	if cfg == base {
+		t.Fatal("WithProjectID returned the receiver, want a copy")
+	}
+	chained := cfg.WithBaseURL("https://example.basecampapi.com").WithCacheEnabled(true)
+	if chained == cfg {
+		t.Fatal("chained With* call returned its receiver, want a copy")
+	}
+
+	if chained.ProjectID != "123" || chained.BaseURL != "https://example.basecampapi.com" || !chained.CacheEnabled {
+		t.Errorf("chained config = %+v, want all three settings applied", chained)
+	}
+	if chained.CacheDir != orig.CacheDir {
+		t.Errorf("CacheDir = %q, want %q carried over", chained.CacheDir, orig.CacheDir)
+	}
+	if *base != orig {
+		t.Errorf("original config modified: %+v, want %+v", *base, orig)
+	}
+	if cfg.BaseURL != orig.BaseURL || cfg.CacheEnabled {
+		t.Errorf("intermediate config modified: %+v", cfg)
+	}
+}
+
+func TestConfig_WithMethods_Invalid(t *testing.T) {
+	tests := map[string]func(*Config){
+		"empty project ID":     func(c *Config) { c.WithProjectID("") },
+		"zero project ID":      func(c *Config) { c.WithProjectID("000") },
+		"negative project ID":  func(c *Config) { c.WithProjectID("-5") },
+		"non-numeric project":  func(c *Config) { c.WithProjectID("proj-99") },
+		"remote http base URL": func(c *Config) { c.WithBaseURL("http://3.basecampapi.com") },
+	}
+	for name, call := range tests {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Error("expected panic")
+				}
+			}()
+			call(DefaultConfig())
+		})
+	}
+
+	if got := DefaultConfig().WithBaseURL("http://localhost:3000").BaseURL; got != "http://localhost:3000" {
+		t.Errorf("localhost BaseURL = %q, want it accepted", got)
+	}
+}
+
+func TestHTTPOptions_Validate(t *testing.T) {
+	if err := DefaultHTTPOptions().Validate(); err != nil {
+		t.Errorf("default options should be valid, got %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		modify func(*HTTPOptions)
+		want   error
+	}{
+		{"zero timeout", func(o *HTTPOptions) { o.Timeout = 0 }, ErrInvalidTimeout},
+		{"negative timeout", func(o *HTTPOptions) { o.Timeout = -time.Second }, ErrInvalidTimeout},
+		{"zero max retries", func(o *HTTPOptions) { o.MaxRetries = 0 }, ErrInvalidMaxRetries},
+		{"negative max retries", func(o *HTTPOptions) { o.MaxRetries = -1 }, ErrInvalidMaxRetries},
+		{"zero max pages", func(o *HTTPOptions) { o.MaxPages = 0 }, ErrInvalidMaxPages},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := DefaultHTTPOptions()
+			tt.modify(&opts)
+			if err := opts.Validate(); !errors.Is(err, tt.want) {
+				t.Errorf("Validate() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewClientWithError_ReportsAllProblems(t *testing.T) {
+	cfg := &Config{BaseURL: "http://3.basecampapi.com"}
+	client, err := NewClientWithError(cfg, &StaticTokenProvider{Token: "token"},
+		WithTimeout(0), WithMaxRetries(0), WithMaxPages(-1))
+	if client != nil {
+		t.Error("expected nil client on invalid configuration")
+	}
+	if err == nil {
+		t.Fatal("expected error for invalid configuration")
+	}
+
+	multi, ok := err.(interface{ Unwrap() []error })
+	if !ok {
+		t.Fatalf("expected multi-error, got %T", err)
+	}
+	if n := len(multi.Unwrap()); n != 2 {
+		// Config and HTTPOptions errors are joined; HTTPOptions holds three.
+		t.Errorf("expected 2 top-level errors, got %d", n)
+	}
+	for _, want := range []error{ErrInsecureBaseURL, ErrInvalidTimeout, ErrInvalidMaxRetries, ErrInvalidMaxPages} {
+		if !errors.Is(err, want) {
+			t.Errorf("expected error to contain %v, got %v", want, err)
+		}
+	}
+}
+
+func TestNewClientWithError_Valid(t *testing.T) {
+	client, err := NewClientWithError(DefaultConfig(), &StaticTokenProvider{Token: "token"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if client == nil {
+		t.Fatal("expected client")
+	}
+}
@@ -30,19 +30,26 @@
 //
 //	// Discover available accounts
 //	info, _ := client.Authorization().GetInfo(ctx, nil)
-//	account := client.ForAccount(fmt.Sprint(info.Accounts[0].ID))
+//	account := client.ForAccountID(info.Accounts[0].ID)
 //
 // # Configuration
 //
 // Configuration can be loaded from environment variables or set programmatically:
 //
 //	cfg := basecamp.DefaultConfig()
-//	cfg.LoadConfigFromEnv()  // Loads BASECAMP_PROJECT_ID, etc.
+//	if err := cfg.LoadConfigFromEnv(); err != nil { // Loads BASECAMP_PROJECT_ID, etc.
+//		log.Fatal(err)
+//	}
 //
 // Environment variables:
+//   - BASECAMP_BASE_URL: API base URL (default: https://3.basecampapi.com)
 //   - BASECAMP_PROJECT_ID: Default project/bucket ID
-//   - BASECAMP_TOKEN: Static API token for authentication
-//   - BASECAMP_CACHE_ENABLED: Enable HTTP caching (default: true)
+//   - BASECAMP_TODOLIST_ID: Default todolist ID
+//   - BASECAMP_TOKEN: Static API token for authentication (read by your code, not LoadConfigFromEnv)
+//   - BASECAMP_CACHE_ENABLED: Enable HTTP caching: true, false, 1, or 0 (default: false)
+//   - BASECAMP_CACHE_DIR: HTTP cache directory (default: $XDG_CACHE_HOME/basecamp)
+//   - BASECAMP_MAX_RETRIES, BASECAMP_TIMEOUT_SECONDS, BASECAMP_MAX_PAGES,
+//     BASECAMP_BASE_DELAY_MS: HTTP retry and pagination tuning
 //
 // # Services
 //
@@ -67,6 +67,7 @@ func (ac *AccountClient) DownloadURL(ctx context.Context, rawURL string) (result
 	op := OperationInfo{
 		Service: "Account", Operation: "DownloadURL",
 		ResourceType: "download", IsMutation: false,
+		AccountID: ac.accountID,
 	}
 	if gater, ok := ac.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -153,7 +154,7 @@ func (c *Client) fetchAPIDownload(ctx context.Context, rawURL string) (*Download
 		if reqErr != nil {
 			return nil, fmt.Errorf("failed to create request: %w", reqErr)
 		}
-		if authErr := c.authStrategy.Authenticate(attemptCtx, req); authErr != nil {
+		if authErr := c.authStrategy.Authenticate(c.withRequestAccountID(attemptCtx, req), req); authErr != nil {
 			return nil, authErr
 		}
 		req.Header.Set("User-Agent", c.userAgent)
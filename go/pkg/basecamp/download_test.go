@@ -6,6 +6,9 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
 	"strings"
 	"sync/atomic"
 	"testing"
@@ -946,3 +949,90 @@ func (h *testGatingHooks) OnOperationGate(ctx context.Context, op OperationInfo)
 	}
 	return ctx, nil
 }
+
+// --- UploadsService.DownloadToFile ---
+
+func TestDownloadToFile(t *testing.T) {
+	mux := http.NewServeMux()
+	apiServer := httptest.NewServer(mux)
+	defer apiServer.Close()
+
+	metadataBody, downloadPath := loadUploadFixture(t, apiServer.URL)
+	mux.HandleFunc("/12345/uploads/1069479400", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(metadataBody)
+	})
+	mux.HandleFunc(downloadPath, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/pdf")
+		_, _ = w.Write([]byte("%PDF-1.7 file body"))
+	})
+
+	cfg := DefaultConfig()
+	cfg.BaseURL = apiServer.URL
+	client := NewClient(cfg, &StaticTokenProvider{Token: "test-token"}, WithTransport(apiServer.Client().Transport))
+
+	dir := t.TempDir()
+	dest := filepath.Join(dir, "report.pdf")
+	if err := client.ForAccount("12345").Uploads().DownloadToFile(context.Background(), 1069479400, dest); err != nil {
+		t.Fatalf("DownloadToFile: %v", err)
+	}
+
+	got, err := os.ReadFile(dest)
+	if err != nil {
+		t.Fatalf("ReadFile: %v", err)
+	}
+	if string(got) != "%PDF-1.7 file body" {
+		t.Errorf("file contents = %q, want %q", got, "%PDF-1.7 file body")
+	}
+	entries, _ := os.ReadDir(dir)
+	if len(entries) != 1 {
+		t.Errorf("expected only the destination file in %s, found %d entries", dir, len(entries))
+	}
+	if runtime.GOOS != "windows" {
+		info, err := os.Stat(dest)
+		if err != nil {
+			t.Fatalf("Stat: %v", err)
+		}
+		if mode := info.Mode().Perm(); mode != 0o644 {
+			t.Errorf("file mode = %o, want 644", mode)
+		}
+	}
+}
+
+func TestDownloadToFile_ErrorLeavesDestinationUntouched(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	cfg := DefaultConfig()
+	cfg.BaseURL = server.URL
+	client := NewClient(cfg, &StaticTokenProvider{Token: "test-token"}, WithTransport(server.Client().Transport))
+
+	dir := t.TempDir()
+	dest := filepath.Join(dir, "existing.txt")
+	if err := os.WriteFile(dest, []byte("original"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.ForAccount("12345").Uploads().DownloadToFile(context.Background(), 1, dest); err == nil {
+		t.Fatal("expected error for missing upload")
+	}
+	got, _ := os.ReadFile(dest)
+	if string(got) != "original" {
+		t.Errorf("destination contents = %q, want %q", got, "original")
+	}
+	entries, _ := os.ReadDir(dir)
+	if len(entries) != 1 {
+		t.Errorf("expected no temp files left behind, found %d entries", len(entries))
+	}
+}
+
+func TestDownloadToFile_RequiresPath(t *testing.T) {
+	client := NewClient(DefaultConfig(), &StaticTokenProvider{Token: "test-token"})
+	err := client.ForAccount("12345").Uploads().DownloadToFile(context.Background(), 1, "")
+	var sdkErr *Error
+	if !isSDKError(err, &sdkErr) || sdkErr.Code != CodeUsage {
+		t.Errorf("expected usage error, got %v", err)
+	}
+}
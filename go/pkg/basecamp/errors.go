@@ -16,6 +16,27 @@ var (
 	ErrRateLimited = errors.New("rate limit exceeded")
 )
 
+// ErrNoCredentials is returned by MultiAccountAuthManager when no credentials
+// are registered for the requested account.
+var ErrNoCredentials = errors.New("no credentials for account")
+
+// Configuration errors reported by Config.Validate, HTTPOptions.Validate,
+// and NewClientWithError. Test for them with errors.Is.
+var (
+	// ErrInsecureBaseURL is returned when a non-localhost base URL does not use HTTPS.
+	ErrInsecureBaseURL = errors.New("base URL must use HTTPS")
+	// ErrEmptyBaseURL is returned when WithBaseURL is given an empty URL.
+	ErrEmptyBaseURL = errors.New("base URL must not be empty")
+	// ErrInvalidTimeout is returned when the HTTP timeout is not positive.
+	ErrInvalidTimeout = errors.New("timeout must be positive")
+	// ErrInvalidMaxRetries is returned when the GET attempt count is below 1.
+	ErrInvalidMaxRetries = errors.New("max retries must be at least 1")
+	// ErrInvalidMaxPages is returned when the pagination cap is not positive.
+	ErrInvalidMaxPages = errors.New("max pages must be positive")
+	// ErrInvalidMaxResponseSize is returned when a response body size limit is not positive.
+	ErrInvalidMaxResponseSize = errors.New("max response size must be positive")
+)
+
 // Error codes for API responses.
 const (
 	CodeUsage      = "usage"
@@ -27,6 +48,12 @@ const (
 	CodeAPI        = "api_error"
 	CodeValidation = "validation"
 	CodeAmbiguous  = "ambiguous"
+
+	CodeConflict        = "conflict"
+	CodePayloadTooLarge = "payload_too_large"
+
+	// CodeUnknown is reported by ErrorCode for errors that did not come from the SDK.
+	CodeUnknown = "unknown"
 )
 
 // Exit codes for CLI tools.
@@ -41,6 +68,9 @@ const (
 	ExitAPI        = 7 // Server returned error
 	ExitAmbiguous  = 8 // Multiple matches for name
 	ExitValidation = 9 // Validation error (422)
+
+	ExitConflict        = 10 // Conflicting state (409)
+	ExitPayloadTooLarge = 11 // Request body too large (413)
 )
 
 // requestIDHeader is the response header carrying the server-issued request ID.
@@ -107,11 +137,31 @@ func ExitCodeFor(code string) int {
 		return ExitValidation
 	case CodeAmbiguous:
 		return ExitAmbiguous
+	case CodeConflict:
+		return ExitConflict
+	case CodePayloadTooLarge:
+		return ExitPayloadTooLarge
 	default:
 		return ExitAPI
 	}
 }
 
+// NewError creates an error with the given code and message. cause, which
+// may be nil, is returned by Unwrap so errors.Is and errors.As can see it.
+func NewError(code, msg string, cause error) *Error {
+	return &Error{Code: code, Message: msg, Cause: cause}
+}
+
+// ErrWrapped creates an error with the given code and message that wraps err
+// and uses err's message as the hint.
+func ErrWrapped(code, msg string, err error) *Error {
+	e := NewError(code, msg, err)
+	if err != nil {
+		e.Hint = err.Error()
+	}
+	return e
+}
+
 // ErrUsage creates a usage error.
 func ErrUsage(msg string) *Error {
 	return &Error{Code: CodeUsage, Message: msg}
@@ -181,15 +231,12 @@ func ErrRateLimit(retryAfter int) *Error {
 	}
 }
 
-// ErrNetwork creates a network error.
+// ErrNetwork creates a retryable network error wrapping cause, which is
+// typically the *url.Error returned by the HTTP client.
 func ErrNetwork(cause error) *Error {
-	return &Error{
-		Code:      CodeNetwork,
-		Message:   "Network error",
-		Hint:      cause.Error(),
-		Retryable: true,
-		Cause:     cause,
-	}
+	e := ErrWrapped(CodeNetwork, "Network error", cause)
+	e.Retryable = true
+	return e
 }
 
 // ErrAPI creates an API error with an HTTP status code.
@@ -201,6 +248,35 @@ func ErrAPI(status int, msg string) *Error {
 	}
 }
 
+// ErrConflict creates a conflict error for a request that clashes with the
+// current state of resource (HTTP 409). detail, if non-empty, is appended to
+// the message.
+func ErrConflict(resource, detail string) *Error {
+	msg := fmt.Sprintf("%s conflict", resource)
+	if detail != "" {
+		msg = fmt.Sprintf("%s: %s", msg, detail)
+	}
+	return &Error{
+		Code:       CodeConflict,
+		Message:    msg,
+		HTTPStatus: 409,
+	}
+}
+
+// ErrPayloadTooLarge creates an error for a request body the server rejected
+// as too large (HTTP 413). limit, if positive, is the size limit in bytes.
+func ErrPayloadTooLarge(limit int64) *Error {
+	e := &Error{
+		Code:       CodePayloadTooLarge,
+		Message:    "Request body too large",
+		HTTPStatus: 413,
+	}
+	if limit > 0 {
+		e.Hint = fmt.Sprintf("Limit is %d bytes", limit)
+	}
+	return e
+}
+
 // ErrAmbiguous creates an ambiguous match error.
 func ErrAmbiguous(resource string, matches []string) *Error {
 	hint := "Be more specific"
@@ -226,3 +302,73 @@ func AsError(err error) *Error {
 		Cause:   err,
 	}
 }
+
+// AsAPIError returns the first *Error in err's chain. Unlike AsError it
+// never wraps: for nil or non-SDK errors it returns nil, false. It is
+// shorthand for errors.AsType[*Error](err).
+func AsAPIError(err error) (*Error, bool) {
+	return errors.AsType[*Error](err)
+}
+
+// AsValidationError returns the first *Error in err's chain if it is a
+// validation error (CodeValidation, typically an HTTP 422). Otherwise it
+// returns nil, false.
+func AsValidationError(err error) (*Error, bool) {
+	e, ok := errors.AsType[*Error](err)
+	if !ok || e.Code != CodeValidation {
+		return nil, false
+	}
+	return e, true
+}
+
+// ErrorCode returns the Code of the first *Error in err's chain, or
+// CodeUnknown if there is none. It returns "" for a nil error.
+func ErrorCode(err error) string {
+	if err == nil {
+		return ""
+	}
+	if e, ok := errors.AsType[*Error](err); ok {
+		return e.Code
+	}
+	return CodeUnknown
+}
+
+// IsNotFound reports whether err is or wraps a not-found *Error.
+func IsNotFound(err error) bool {
+	return hasCode(err, CodeNotFound)
+}
+
+// IsRateLimit reports whether err is or wraps a rate-limit *Error.
+func IsRateLimit(err error) bool {
+	return hasCode(err, CodeRateLimit)
+}
+
+// IsAuth reports whether err is or wraps an authentication *Error.
+func IsAuth(err error) bool {
+	return hasCode(err, CodeAuth)
+}
+
+// IsValidation reports whether err is or wraps a validation *Error.
+func IsValidation(err error) bool {
+	return hasCode(err, CodeValidation)
+}
+
+// IsConflict reports whether err is or wraps a conflict *Error.
+func IsConflict(err error) bool {
+	return hasCode(err, CodeConflict)
+}
+
+// IsPayloadTooLarge reports whether err is or wraps a payload-too-large *Error.
+func IsPayloadTooLarge(err error) bool {
+	return hasCode(err, CodePayloadTooLarge)
+}
+
+// IsNetworkError reports whether err is or wraps a network *Error.
+func IsNetworkError(err error) bool {
+	return hasCode(err, CodeNetwork)
+}
+
+func hasCode(err error, code string) bool {
+	e, ok := errors.AsType[*Error](err)
+	return ok && e.Code == code
+}
@@ -1,8 +1,10 @@
 package basecamp
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"net/url"
 	"testing"
 )
 
@@ -84,6 +86,8 @@ func TestExitCodeFor(t *testing.T) {
 		{CodeAPI, ExitAPI},
 		{CodeValidation, ExitValidation},
 		{CodeAmbiguous, ExitAmbiguous},
+		{CodeConflict, ExitConflict},
+		{CodePayloadTooLarge, ExitPayloadTooLarge},
 		{"unknown_code", ExitAPI},
 	}
 
@@ -219,3 +223,173 @@ func TestSentinelErrors(t *testing.T) {
 		t.Error("ErrRateLimited should not be nil")
 	}
 }
+
+func TestIsHelpers(t *testing.T) {
+	helpers := map[string]func(error) bool{
+		CodeNotFound:        IsNotFound,
+		CodeRateLimit:       IsRateLimit,
+		CodeAuth:            IsAuth,
+		CodeValidation:      IsValidation,
+		CodeNetwork:         IsNetworkError,
+		CodeConflict:        IsConflict,
+		CodePayloadTooLarge: IsPayloadTooLarge,
+	}
+	errs := map[string]error{
+		CodeNotFound:        ErrNotFound("Project", "1"),
+		CodeRateLimit:       ErrRateLimit(30),
+		CodeAuth:            ErrAuth("Not authenticated"),
+		CodeValidation:      &Error{Code: CodeValidation, Message: "invalid", HTTPStatus: 422},
+		CodeNetwork:         ErrNetwork(errors.New("connection refused")),
+		CodeConflict:        ErrConflict("Card", "column was moved"),
+		CodePayloadTooLarge: ErrPayloadTooLarge(1 << 20),
+	}
+
+	for helperCode, is := range helpers {
+		for errCode, sdkErr := range errs {
+			want := helperCode == errCode
+			for depth, err := range []error{
+				sdkErr,
+				fmt.Errorf("wrap: %w", sdkErr),
+				fmt.Errorf("outer: %w", fmt.Errorf("inner: %w", sdkErr)),
+			} {
+				if got := is(err); got != want {
+					t.Errorf("Is %s(%s error, depth %d) = %v, want %v", helperCode, errCode, depth, got, want)
+				}
+			}
+		}
+		if is(errors.New("plain")) {
+			t.Errorf("Is %s(plain error) = true, want false", helperCode)
+		}
+		if is(nil) {
+			t.Errorf("Is %s(nil) = true, want false", helperCode)
+		}
+	}
+}
+
+func TestErrorCode(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{"nil", nil, ""},
+		{"sdk error", ErrNotFound("Todo", "1"), CodeNotFound},
+		{"wrapped twice", fmt.Errorf("a: %w", fmt.Errorf("b: %w", ErrAuth("no"))), CodeAuth},
+		{"plain error", errors.New("boom"), CodeUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ErrorCode(tt.err); got != tt.want {
+				t.Errorf("ErrorCode() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAsAPIError(t *testing.T) {
+	inner := ErrNotFound("Todo", "1")
+	deep := fmt.Errorf("a: %w", fmt.Errorf("b: %w", fmt.Errorf("c: %w", inner)))
+
+	got, ok := AsAPIError(deep)
+	if !ok || got != inner {
+		t.Errorf("AsAPIError(wrapped x3) = %v, %v; want %v, true", got, ok, inner)
+	}
+
+	for _, err := range []error{nil, errors.New("plain"), fmt.Errorf("wrap: %w", errors.New("plain"))} {
+		if got, ok := AsAPIError(err); got != nil || ok {
+			t.Errorf("AsAPIError(%v) = %v, %v; want nil, false", err, got, ok)
+		}
+	}
+}
+
+func TestAsValidationError(t *testing.T) {
+	inner := &Error{Code: CodeValidation, Message: "Name can't be blank", HTTPStatus: 422}
+	deep := fmt.Errorf("a: %w", fmt.Errorf("b: %w", fmt.Errorf("c: %w", inner)))
+
+	got, ok := AsValidationError(deep)
+	if !ok || got != inner {
+		t.Errorf("AsValidationError(wrapped x3) = %v, %v; want %v, true", got, ok, inner)
+	}
+
+	for _, err := range []error{nil, errors.New("plain"), ErrNotFound("Todo", "1")} {
+		if got, ok := AsValidationError(err); got != nil || ok {
+			t.Errorf("AsValidationError(%v) = %v, %v; want nil, false", err, got, ok)
+		}
+	}
+}
+
+func TestNewError(t *testing.T) {
+	cause := errors.New("boom")
+	e := NewError(CodeAPI, "request failed", cause)
+	if e.Code != CodeAPI || e.Message != "request failed" || e.Hint != "" {
+		t.Errorf("NewError = %+v", e)
+	}
+	if !errors.Is(e, cause) {
+		t.Error("expected errors.Is to find cause")
+	}
+	if NewError(CodeUsage, "bad", nil).Unwrap() != nil {
+		t.Error("expected nil cause to unwrap to nil")
+	}
+}
+
+func TestErrWrapped(t *testing.T) {
+	cause := errors.New("connection reset")
+	e := ErrWrapped(CodeNetwork, "Network error", cause)
+	if e.Hint != "connection reset" {
+		t.Errorf("Hint = %q, want %q", e.Hint, "connection reset")
+	}
+	if !errors.Is(e, cause) {
+		t.Error("expected errors.Is to find cause")
+	}
+	if e.Retryable {
+		t.Error("ErrWrapped should not mark errors retryable")
+	}
+	if got := ErrWrapped(CodeAPI, "failed", nil); got.Hint != "" || got.Cause != nil {
+		t.Errorf("ErrWrapped(nil) = %+v", got)
+	}
+}
+
+func TestErrNetwork_WrapsCause(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	client := NewClient(&Config{BaseURL: "http://localhost:1"}, &StaticTokenProvider{Token: "test-token"})
+	_, err := client.ForAccount("12345").Post(ctx, "/projects.json", map[string]any{"name": "x"})
+
+	if !IsNetworkError(err) {
+		t.Fatalf("expected network error, got %v", err)
+	}
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("errors.Is(err, context.Canceled) = false for %v", err)
+	}
+	if _, ok := errors.AsType[*url.Error](err); !ok {
+		t.Errorf("expected *url.Error in chain of %v", err)
+	}
+}
+
+func TestErrConflict(t *testing.T) {
+	err := ErrConflict("Card", "column was moved")
+	if err.Code != CodeConflict || err.HTTPStatus != 409 || err.Retryable {
+		t.Errorf("ErrConflict = %+v, want non-retryable conflict with status 409", err)
+	}
+	if err.Message != "Card conflict: column was moved" || err.Hint != "" {
+		t.Errorf("Message = %q, Hint = %q, want detail in Message", err.Message, err.Hint)
+	}
+	if got := ErrConflict("Card", "").Message; got != "Card conflict" {
+		t.Errorf("ErrConflict(\"Card\", \"\").Message = %q", got)
+	}
+}
+
+func TestErrPayloadTooLarge(t *testing.T) {
+	err := ErrPayloadTooLarge(1024)
+	if err.Code != CodePayloadTooLarge || err.HTTPStatus != 413 || err.Retryable {
+		t.Errorf("ErrPayloadTooLarge = %+v, want non-retryable payload_too_large with status 413", err)
+	}
+	if err.Error() != "Request body too large: Limit is 1024 bytes" {
+		t.Errorf("Error() = %q", err.Error())
+	}
+	if got := ErrPayloadTooLarge(0).Hint; got != "" {
+		t.Errorf("ErrPayloadTooLarge(0).Hint = %q, want empty", got)
+	}
+}
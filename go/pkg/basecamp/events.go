@@ -22,6 +22,11 @@ type EventListOptions struct {
 	// NOTE: The page number itself is not yet honored due to OpenAPI client
 	// limitations. Use 0 to paginate through all results up to Limit.
 	Page int
+
+	// PersonID, if set, restricts results to events created by that person.
+	// The endpoint has no creator filter, so every page is fetched and
+	// filtered client-side; Limit then caps the filtered events.
+	PersonID *int64
 }
 
 // EventListResult contains the results from listing events.
@@ -73,6 +78,8 @@ func NewEventsService(client *AccountClient) *EventsService {
 //   - Limit: maximum number of events to return (0 = 100, -1 = unlimited)
 //   - Page: if non-zero, disables pagination and returns first page only
 //
+// Set PersonID to only return events created by one person.
+//
 // The returned EventListResult includes pagination metadata (TotalCount from
 // X-Total-Count header) when available.
 func (s *EventsService) List(ctx context.Context, recordingID int64, opts *EventListOptions) (result *EventListResult, err error) {
@@ -80,6 +87,7 @@ func (s *EventsService) List(ctx context.Context, recordingID int64, opts *Event
 		Service: "Events", Operation: "List",
 		ResourceType: "event", IsMutation: false,
 		ResourceID: recordingID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -101,6 +109,7 @@ func (s *EventsService) List(ctx context.Context, recordingID int64, opts *Event
 
 	// Capture total count from X-Total-Count header (first page only)
 	totalCount := parseTotalCount(resp.HTTPResponse)
+	totalPages := parseTotalPages(resp.HTTPResponse, firstPageLen(resp.JSON200))
 
 	// Parse first page
 	var events []Event
@@ -110,9 +119,16 @@ func (s *EventsService) List(ctx context.Context, recordingID int64, opts *Event
 		}
 	}
 
+	// The spec declares no creator filter for this endpoint, so PersonID is
+	// applied after fetching.
+	byPerson := opts != nil && opts.PersonID != nil
+
 	// Handle single page fetch (--page flag)
 	if opts != nil && opts.Page > 0 {
-		return &EventListResult{Events: events, Meta: ListMeta{TotalCount: totalCount}}, nil
+		if byPerson {
+			events = eventsByCreator(events, *opts.PersonID)
+		}
+		return &EventListResult{Events: events, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages}}, nil
 	}
 
 	// Determine limit: 0 = default (100), -1 = unlimited, >0 = specific limit
@@ -126,12 +142,18 @@ func (s *EventsService) List(ctx context.Context, recordingID int64, opts *Event
 	}
 
 	// Check if we already have enough items
-	if limit > 0 && len(events) >= limit {
-		return &EventListResult{Events: events[:limit], Meta: ListMeta{TotalCount: totalCount, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(events), limit)}}, nil
+	if !byPerson && limit > 0 && len(events) >= limit {
+		return &EventListResult{Events: events[:limit], Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(events), limit)}}, nil
 	}
 
-	// Follow pagination via Link headers (uses absolute URLs from API, no path construction)
-	rawMore, truncated, err := s.client.parent.followPagination(ctx, resp.HTTPResponse, len(events), limit)
+	// Follow pagination via Link headers (uses absolute URLs from API, no path construction).
+	// A person filter is applied after fetching, so fetch every page and cap
+	// the filtered events instead.
+	fetchLimit := limit
+	if byPerson {
+		fetchLimit = 0
+	}
+	rawMore, truncated, err := s.client.parent.followPagination(ctx, resp.HTTPResponse, len(events), fetchLimit)
 	if err != nil {
 		return nil, err
 	}
@@ -145,7 +167,40 @@ func (s *EventsService) List(ctx context.Context, recordingID int64, opts *Event
 		events = append(events, eventFromGenerated(ge))
 	}
 
-	return &EventListResult{Events: events, Meta: ListMeta{TotalCount: totalCount, Truncated: truncated}}, nil
+	if byPerson {
+		events = eventsByCreator(events, *opts.PersonID)
+		if limit > 0 && len(events) > limit {
+			events = events[:limit]
+			truncated = true
+		}
+	}
+
+	return &EventListResult{Events: events, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: truncated}}, nil
+}
+
+// ListByPerson returns the events on a recording that were created by
+// personID. Any PersonID in opts is replaced. Basecamp has no account-wide
+// events endpoint; use TimelineService.PersonProgress for a person's activity
+// across projects. ListByPerson is built on List; hooks observe the List
+// operation.
+func (s *EventsService) ListByPerson(ctx context.Context, recordingID, personID int64, opts *EventListOptions) (*EventListResult, error) {
+	byPerson := EventListOptions{}
+	if opts != nil {
+		byPerson = *opts
+	}
+	byPerson.PersonID = &personID
+	return s.List(ctx, recordingID, &byPerson)
+}
+
+// eventsByCreator returns the events created by personID, in order.
+func eventsByCreator(events []Event, personID int64) []Event {
+	var kept []Event
+	for _, e := range events {
+		if e.Creator != nil && e.Creator.ID == personID {
+			kept = append(kept, e)
+		}
+	}
+	return kept
 }
 
 // eventFromGenerated converts a generated Event to our clean type.
@@ -1,9 +1,14 @@
 package basecamp
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"strconv"
 	"testing"
 )
 
@@ -120,3 +125,108 @@ func TestEvent_UnmarshalList(t *testing.T) {
 		t.Errorf("expected Creator.Name 'Andrew Wong', got %q", e3.Creator.Name)
 	}
 }
+
+// testEventsServer creates an httptest.Server serving the events list fixture
+// and an EventsService wired to it. Each request's raw query is recorded.
+func testEventsServer(t *testing.T, queries *[]string, opts ...ClientOption) *EventsService {
+	t.Helper()
+	fixture := loadEventsFixture(t, "list.json")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			t.Errorf("expected GET, got %s", r.Method)
+		}
+		if r.URL.Path != "/99999/recordings/42/events.json" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		*queries = append(*queries, r.URL.RawQuery)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(fixture)
+	}))
+	t.Cleanup(server.Close)
+
+	cfg := DefaultConfig()
+	cfg.BaseURL = server.URL
+	client := NewClient(cfg, &StaticTokenProvider{Token: "test-token"}, opts...)
+	return client.ForAccount("99999").Events()
+}
+
+func TestEventsService_List_PersonID(t *testing.T) {
+	var queries []string
+	svc := testEventsServer(t, &queries)
+
+	personID := int64(1049715915)
+	result, err := svc.List(context.Background(), 42, &EventListOptions{PersonID: &personID})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(result.Events) != 2 {
+		t.Fatalf("expected 2 events by Annie Bryan, got %d", len(result.Events))
+	}
+	for _, e := range result.Events {
+		if e.Creator == nil || e.Creator.ID != personID {
+			t.Errorf("event %d has creator %+v, want ID %d", e.ID, e.Creator, personID)
+		}
+	}
+	if len(queries) != 1 || queries[0] != "" {
+		t.Errorf("queries = %q, want no query parameters", queries)
+	}
+}
+
+func TestEventsService_List_PersonIDFetchesAllPages(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		if page == 0 {
+			page = 1
+		}
+		if page < 3 {
+			w.Header().Set("Link", fmt.Sprintf(`<http://%s/99999/recordings/42/events.json?page=%d>; rel="next"`, r.Host, page+1))
+		}
+		// Each page has one event by person 1 and one by person 2.
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `[{"id":%d,"action":"commented","creator":{"id":1,"name":"One"}},{"id":%d,"action":"commented","creator":{"id":2,"name":"Two"}}]`, page*10+1, page*10+2)
+	}))
+	defer server.Close()
+
+	cfg := DefaultConfig()
+	cfg.BaseURL = server.URL
+	svc := NewClient(cfg, &StaticTokenProvider{Token: "test-token"}).ForAccount("99999").Events()
+
+	personID := int64(2)
+	result, err := svc.List(context.Background(), 42, &EventListOptions{PersonID: &personID, Limit: 2})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if requests != 3 {
+		t.Errorf("expected 3 requests, got %d", requests)
+	}
+	if len(result.Events) != 2 || result.Events[0].ID != 12 || result.Events[1].ID != 22 {
+		t.Errorf("events = %+v, want IDs 12 and 22", result.Events)
+	}
+	if !result.Meta.Truncated {
+		t.Error("expected Truncated when Limit caps the filtered events")
+	}
+}
+
+func TestEventsService_ListByPerson(t *testing.T) {
+	var queries []string
+	hooks := &recordingHooks{}
+	svc := testEventsServer(t, &queries, WithHooks(hooks))
+
+	other := int64(7)
+	opts := &EventListOptions{Limit: 5, PersonID: &other}
+	result, err := svc.ListByPerson(context.Background(), 42, 1049715923, opts)
+	if err != nil {
+		t.Fatalf("ListByPerson() error = %v", err)
+	}
+	if len(result.Events) != 1 || result.Events[0].ID != 1069479402 {
+		t.Errorf("events = %+v, want only Andrew Wong's event", result.Events)
+	}
+	if *opts.PersonID != 7 {
+		t.Errorf("caller's options were modified: PersonID = %d", *opts.PersonID)
+	}
+	if len(hooks.opStartCalls) != 1 || hooks.opStartCalls[0].Operation != "List" {
+		t.Errorf("unexpected operation info: %+v", hooks.opStartCalls)
+	}
+}
@@ -58,7 +58,9 @@ func ExampleDefaultConfig() {
 	cfg := basecamp.DefaultConfig()
 
 	// Override with environment variables
-	cfg.LoadConfigFromEnv()
+	if err := cfg.LoadConfigFromEnv(); err != nil {
+		log.Fatal(err)
+	}
 
 	// Or set values programmatically
 	cfg.ProjectID = "67890"
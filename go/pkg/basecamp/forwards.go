@@ -142,6 +142,7 @@ func (s *ForwardsService) GetInbox(ctx context.Context, inboxID int64) (result *
 		Service: "Forwards", Operation: "GetInbox",
 		ResourceType: "inbox", IsMutation: false,
 		ResourceID: inboxID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -183,6 +184,7 @@ func (s *ForwardsService) List(ctx context.Context, inboxID int64, opts *Forward
 		Service: "Forwards", Operation: "List",
 		ResourceType: "forward", IsMutation: false,
 		ResourceID: inboxID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -211,6 +213,7 @@ func (s *ForwardsService) List(ctx context.Context, inboxID int64, opts *Forward
 
 	// Capture total count from X-Total-Count header (first page only)
 	totalCount := parseTotalCount(resp.HTTPResponse)
+	totalPages := parseTotalPages(resp.HTTPResponse, firstPageLen(resp.JSON200))
 
 	// Parse first page
 	var forwards []Forward
@@ -222,7 +225,7 @@ func (s *ForwardsService) List(ctx context.Context, inboxID int64, opts *Forward
 
 	// Handle single page fetch (--page flag)
 	if opts != nil && opts.Page > 0 {
-		return &ForwardListResult{Forwards: forwards, Meta: ListMeta{TotalCount: totalCount}}, nil
+		return &ForwardListResult{Forwards: forwards, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages}}, nil
 	}
 
 	// Determine limit: 0 = all (default for forwards), >0 = specific limit
@@ -233,7 +236,7 @@ func (s *ForwardsService) List(ctx context.Context, inboxID int64, opts *Forward
 
 	// Check if we already have enough items
 	if limit > 0 && len(forwards) >= limit {
-		return &ForwardListResult{Forwards: forwards[:limit], Meta: ListMeta{TotalCount: totalCount, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(forwards), limit)}}, nil
+		return &ForwardListResult{Forwards: forwards[:limit], Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(forwards), limit)}}, nil
 	}
 
 	// Follow pagination via Link headers (uses absolute URLs from API, no path construction)
@@ -251,7 +254,7 @@ func (s *ForwardsService) List(ctx context.Context, inboxID int64, opts *Forward
 		forwards = append(forwards, forwardFromGenerated(gf))
 	}
 
-	return &ForwardListResult{Forwards: forwards, Meta: ListMeta{TotalCount: totalCount, Truncated: truncated}}, nil
+	return &ForwardListResult{Forwards: forwards, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: truncated}}, nil
 }
 
 // Get returns a forward by ID.
@@ -260,6 +263,7 @@ func (s *ForwardsService) Get(ctx context.Context, forwardID int64) (result *For
 		Service: "Forwards", Operation: "Get",
 		ResourceType: "forward", IsMutation: false,
 		ResourceID: forwardID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -286,6 +290,31 @@ func (s *ForwardsService) Get(ctx context.Context, forwardID int64) (result *For
 	return &forward, nil
 }
 
+// Trash moves a forward to the trash.
+// Trashed forwards can be recovered from the trash.
+func (s *ForwardsService) Trash(ctx context.Context, forwardID int64) (err error) {
+	op := OperationInfo{
+		Service: "Forwards", Operation: "Trash",
+		ResourceType: "forward", IsMutation: true,
+		ResourceID: forwardID,
+		AccountID:  s.client.accountID,
+	}
+	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
+		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
+			return
+		}
+	}
+	start := time.Now()
+	ctx = s.client.parent.hooks.OnOperationStart(ctx, op)
+	defer func() { s.client.parent.hooks.OnOperationEnd(ctx, op, err, time.Since(start)) }()
+
+	resp, err := s.client.parent.gen.TrashRecordingWithResponse(ctx, s.client.accountID, forwardID)
+	if err != nil {
+		return err
+	}
+	return checkResponse(resp.HTTPResponse, resp.Body)
+}
+
 // ListReplies returns all replies to a forward.
 //
 // By default, returns all replies (no limit). Use Limit to cap results.
@@ -301,6 +330,7 @@ func (s *ForwardsService) ListReplies(ctx context.Context, forwardID int64, opts
 		Service: "Forwards", Operation: "ListReplies",
 		ResourceType: "forward_reply", IsMutation: false,
 		ResourceID: forwardID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -322,6 +352,7 @@ func (s *ForwardsService) ListReplies(ctx context.Context, forwardID int64, opts
 
 	// Capture total count from X-Total-Count header (first page only)
 	totalCount := parseTotalCount(resp.HTTPResponse)
+	totalPages := parseTotalPages(resp.HTTPResponse, firstPageLen(resp.JSON200))
 
 	// Parse first page
 	var replies []ForwardReply
@@ -333,7 +364,7 @@ func (s *ForwardsService) ListReplies(ctx context.Context, forwardID int64, opts
 
 	// Handle single page fetch (--page flag)
 	if opts != nil && opts.Page > 0 {
-		return &ForwardReplyListResult{Replies: replies, Meta: ListMeta{TotalCount: totalCount}}, nil
+		return &ForwardReplyListResult{Replies: replies, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages}}, nil
 	}
 
 	// Determine limit: 0 = all (default for replies), >0 = specific limit
@@ -344,7 +375,7 @@ func (s *ForwardsService) ListReplies(ctx context.Context, forwardID int64, opts
 
 	// Check if we already have enough items
 	if limit > 0 && len(replies) >= limit {
-		return &ForwardReplyListResult{Replies: replies[:limit], Meta: ListMeta{TotalCount: totalCount, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(replies), limit)}}, nil
+		return &ForwardReplyListResult{Replies: replies[:limit], Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(replies), limit)}}, nil
 	}
 
 	// Follow pagination via Link headers (uses absolute URLs from API, no path construction)
@@ -362,7 +393,7 @@ func (s *ForwardsService) ListReplies(ctx context.Context, forwardID int64, opts
 		replies = append(replies, forwardReplyFromGenerated(gr))
 	}
 
-	return &ForwardReplyListResult{Replies: replies, Meta: ListMeta{TotalCount: totalCount, Truncated: truncated}}, nil
+	return &ForwardReplyListResult{Replies: replies, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: truncated}}, nil
 }
 
 // GetReply returns a forward reply by ID.
@@ -371,6 +402,7 @@ func (s *ForwardsService) GetReply(ctx context.Context, forwardID, replyID int64
 		Service: "Forwards", Operation: "GetReply",
 		ResourceType: "forward_reply", IsMutation: false,
 		ResourceID: replyID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -404,6 +436,7 @@ func (s *ForwardsService) CreateReply(ctx context.Context, forwardID int64, req
 		Service: "Forwards", Operation: "CreateReply",
 		ResourceType: "forward_reply", IsMutation: true,
 		ResourceID: forwardID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
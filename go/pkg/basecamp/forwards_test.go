@@ -1,7 +1,11 @@
 package basecamp
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"testing"
@@ -382,3 +386,69 @@ func TestCreateForwardReplyRequest_Marshal(t *testing.T) {
 		t.Errorf("unexpected content: %v", result["content"])
 	}
 }
+
+func testForwardsServer(t *testing.T, handler http.HandlerFunc) *ForwardsService {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	cfg := DefaultConfig()
+	cfg.BaseURL = server.URL
+	client := NewClient(cfg, &StaticTokenProvider{Token: "test-token"})
+	return client.ForAccount("99999").Forwards()
+}
+
+func TestForwardsService_List_Pagination(t *testing.T) {
+	var paths []string
+	svc := testForwardsServer(t, func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("page") == "2" {
+			w.Write([]byte(`[{"id":3,"subject":"Third"}]`))
+			return
+		}
+		w.Header().Set("Link", fmt.Sprintf(`<http://%s/99999/inboxes/1069479339/forwards.json?page=2>; rel="next"`, r.Host))
+		w.Write([]byte(`[{"id":1,"subject":"First"},{"id":2,"subject":"Second"}]`))
+	})
+
+	result, err := svc.List(context.Background(), 1069479339, nil)
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+
+	if len(paths) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(paths))
+	}
+	for _, p := range paths {
+		if p != "/99999/inboxes/1069479339/forwards.json" {
+			t.Errorf("expected inbox forwards path, got %q", p)
+		}
+	}
+	if len(result.Forwards) != 3 {
+		t.Fatalf("expected 3 forwards, got %d", len(result.Forwards))
+	}
+	for i, f := range result.Forwards {
+		if f.ID != int64(i+1) {
+			t.Errorf("forward %d: expected ID %d, got %d", i, i+1, f.ID)
+		}
+	}
+}
+
+func TestForwardsService_Trash(t *testing.T) {
+	var method, path string
+	svc := testForwardsServer(t, func(w http.ResponseWriter, r *http.Request) {
+		method = r.Method
+		path = r.URL.Path
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if err := svc.Trash(context.Background(), 1069479345); err != nil {
+		t.Fatalf("Trash() error = %v", err)
+	}
+	if method != http.MethodPut {
+		t.Errorf("expected PUT, got %s", method)
+	}
+	if path != "/99999/recordings/1069479345/status/trashed.json" {
+		t.Errorf("expected recording trash path, got %q", path)
+	}
+}
@@ -106,6 +106,7 @@ func (s *GaugesService) List(ctx context.Context) (result []Gauge, err error) {
 	op := OperationInfo{
 		Service: "Gauges", Operation: "List",
 		ResourceType: "gauge", IsMutation: false,
+		AccountID: s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -150,6 +151,7 @@ func (s *GaugesService) ListNeedles(ctx context.Context, projectID int64) (resul
 	op := OperationInfo{
 		Service: "Gauges", Operation: "ListNeedles",
 		ResourceType: "gauge_needle", IsMutation: false,
+		AccountID: s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -195,6 +197,7 @@ func (s *GaugesService) GetNeedle(ctx context.Context, needleID int64) (result *
 		Service: "Gauges", Operation: "GetNeedle",
 		ResourceType: "gauge_needle", IsMutation: false,
 		ResourceID: needleID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -226,6 +229,7 @@ func (s *GaugesService) CreateNeedle(ctx context.Context, projectID int64, req *
 	op := OperationInfo{
 		Service: "Gauges", Operation: "CreateNeedle",
 		ResourceType: "gauge_needle", IsMutation: true,
+		AccountID: s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -275,6 +279,7 @@ func (s *GaugesService) UpdateNeedle(ctx context.Context, needleID int64, req *U
 		Service: "Gauges", Operation: "UpdateNeedle",
 		ResourceType: "gauge_needle", IsMutation: true,
 		ResourceID: needleID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -318,6 +323,7 @@ func (s *GaugesService) DestroyNeedle(ctx context.Context, needleID int64) (err
 		Service: "Gauges", Operation: "DestroyNeedle",
 		ResourceType: "gauge_needle", IsMutation: true,
 		ResourceID: needleID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -340,6 +346,7 @@ func (s *GaugesService) Toggle(ctx context.Context, projectID int64, enabled boo
 	op := OperationInfo{
 		Service: "Gauges", Operation: "Toggle",
 		ResourceType: "gauge", IsMutation: true,
+		AccountID: s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
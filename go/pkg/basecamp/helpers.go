@@ -88,6 +88,14 @@ func checkResponse(resp *http.Response, body []byte) error {
 		return &Error{Code: CodeForbidden, Message: msgOrDefault(serverMsg, "access denied"), Hint: serverHint, HTTPStatus: 403, RequestID: requestID}
 	case http.StatusNotFound:
 		return &Error{Code: CodeNotFound, Message: msgOrDefault(serverMsg, "resource not found"), Hint: serverHint, HTTPStatus: 404, RequestID: requestID}
+	case http.StatusConflict:
+		e := ErrConflict("Resource", "")
+		e.Message, e.Hint, e.RequestID = msgOrDefault(serverMsg, e.Message), serverHint, requestID
+		return e
+	case http.StatusRequestEntityTooLarge:
+		e := ErrPayloadTooLarge(0)
+		e.Message, e.Hint, e.RequestID = msgOrDefault(serverMsg, e.Message), serverHint, requestID
+		return e
 	case http.StatusUnprocessableEntity:
 		return &Error{Code: CodeValidation, Message: msgOrDefault(serverMsg, "validation error"), Hint: serverHint, HTTPStatus: 422, RequestID: requestID}
 	case http.StatusTooManyRequests:
@@ -156,6 +164,10 @@ type ListMeta struct {
 	// TotalCount is the total number of items available (from X-Total-Count header).
 	// Zero if the header was not present or could not be parsed.
 	TotalCount int
+	// TotalPages is the total number of pages available, derived from
+	// TotalCount and the size of the first page. Zero if TotalCount is zero
+	// or the page size could not be determined.
+	TotalPages int
 	// Truncated is true when results were capped by MaxPages or Limit, either
 	// because more pages are available on the server or because items were
 	// dropped within a page due to the limit.
@@ -180,13 +192,71 @@ func isFirstPageTruncated(resp *http.Response, itemCount, limit int) bool {
 	return parseNextLink(resp.Header.Get("Link")) != ""
 }
 
+// IsLastPage reports whether page (1-based) is the last page, given the
+// number of items collected through that page. It uses TotalPages when it is
+// known and falls back to TotalCount; with neither it returns false.
+func (m ListMeta) IsLastPage(page, itemCount int) bool {
+	if m.TotalPages > 0 {
+		return page >= m.TotalPages
+	}
+	return m.TotalCount > 0 && itemCount >= m.TotalCount
+}
+
 // parseTotalCount extracts the total count from X-Total-Count header.
 // Returns 0 if the header is missing or cannot be parsed.
 func parseTotalCount(resp *http.Response) int {
+	return parseCountHeader(resp, "X-Total-Count")
+}
+
+// parseTotalPages derives the total page count from the X-Total-Count header
+// and pageLen, the number of items on the page in resp. The API sends no page
+// count header, so a page with a next link is taken to be full, and a first
+// page without one is the only page. Returns 0 if the count is unknown.
+func parseTotalPages(resp *http.Response, pageLen int) int {
+	total := parseTotalCount(resp)
+	if total == 0 {
+		return 0
+	}
+	if parseNextLink(resp.Header.Get("Link")) == "" {
+		if requestedPage(resp) > 1 {
+			return 0 // a last page's length is not the page size
+		}
+		return 1
+	}
+	if pageLen <= 0 {
+		return 0
+	}
+	return (total + pageLen - 1) / pageLen
+}
+
+// requestedPage returns the page query parameter of the request that
+// produced resp, or 1 when it is absent or invalid.
+func requestedPage(resp *http.Response) int {
+	if resp.Request == nil || resp.Request.URL == nil {
+		return 1
+	}
+	page, err := strconv.Atoi(resp.Request.URL.Query().Get("page"))
+	if err != nil || page < 1 {
+		return 1
+	}
+	return page
+}
+
+// firstPageLen returns the number of items in a decoded list response.
+func firstPageLen[T any](items *[]T) int {
+	if items == nil {
+		return 0
+	}
+	return len(*items)
+}
+
+// parseCountHeader parses a non-negative integer header, returning 0 if the
+// header is missing or invalid.
+func parseCountHeader(resp *http.Response, name string) int {
 	if resp == nil {
 		return 0
 	}
-	header := resp.Header.Get("X-Total-Count")
+	header := resp.Header.Get(name)
 	if header == "" {
 		return 0
 	}
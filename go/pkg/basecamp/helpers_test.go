@@ -3,6 +3,7 @@ package basecamp
 import (
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -30,6 +31,8 @@ func TestCheckResponse_ErrorCodes(t *testing.T) {
 		{401, CodeAuth, false},
 		{403, CodeForbidden, false},
 		{404, CodeNotFound, false},
+		{409, CodeConflict, false},
+		{413, CodePayloadTooLarge, false},
 		{422, CodeValidation, false},
 		{429, CodeRateLimit, true},
 		{500, CodeAPI, true},
@@ -162,6 +165,71 @@ func TestParseTotalCount_NilResponse(t *testing.T) {
 	}
 }
 
+func TestParseTotalPages(t *testing.T) {
+	const next = `<https://3.basecampapi.com/99999/projects.json?page=2>; rel="next"`
+	tests := []struct {
+		name    string
+		count   string
+		link    string
+		url     string
+		pageLen int
+		want    int
+	}{
+		{"full first page", "120", next, "/projects.json", 50, 3},
+		{"exact multiple", "100", next, "/projects.json", 50, 2},
+		{"only page", "7", "", "/projects.json", 7, 1},
+		{"later full page", "120", next, "/projects.json?page=2", 50, 3},
+		{"last page", "120", "", "/projects.json?page=3", 20, 0},
+		{"no count", "", next, "/projects.json", 50, 0},
+		{"empty page with next link", "120", next, "/projects.json", 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "https://3.basecampapi.com/99999"+tt.url, nil)
+			resp := &http.Response{Header: http.Header{}, Request: req}
+			if tt.count != "" {
+				resp.Header.Set("X-Total-Count", tt.count)
+			}
+			if tt.link != "" {
+				resp.Header.Set("Link", tt.link)
+			}
+			if got := parseTotalPages(resp, tt.pageLen); got != tt.want {
+				t.Errorf("parseTotalPages() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+
+	if got := parseTotalPages(nil, 10); got != 0 {
+		t.Errorf("parseTotalPages(nil) = %d, want 0", got)
+	}
+}
+
+func TestListMeta_IsLastPage(t *testing.T) {
+	tests := []struct {
+		name      string
+		meta      ListMeta
+		page      int
+		itemCount int
+		want      bool
+	}{
+		{"pages: before last", ListMeta{TotalPages: 3, TotalCount: 30}, 2, 20, false},
+		{"pages: last", ListMeta{TotalPages: 3, TotalCount: 30}, 3, 30, true},
+		{"pages take precedence", ListMeta{TotalPages: 3, TotalCount: 10}, 1, 10, false},
+		{"count: short", ListMeta{TotalCount: 30}, 1, 15, false},
+		{"count: reached", ListMeta{TotalCount: 30}, 2, 30, true},
+		{"no headers", ListMeta{}, 5, 100, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.meta.IsLastPage(tt.page, tt.itemCount); got != tt.want {
+				t.Errorf("IsLastPage(%d, %d) = %v, want %v", tt.page, tt.itemCount, got, tt.want)
+			}
+		})
+	}
+}
+
 func TestMarshalBody_ReturnsReplayableReader(t *testing.T) {
 	reader, err := marshalBody(map[string]any{"content": "Updated content"})
 	if err != nil {
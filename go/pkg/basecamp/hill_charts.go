@@ -44,6 +44,7 @@ func (s *HillChartsService) Get(ctx context.Context, todosetID int64) (result *H
 		Service: "HillCharts", Operation: "Get",
 		ResourceType: "hill_chart", IsMutation: false,
 		ResourceID: todosetID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -77,6 +78,7 @@ func (s *HillChartsService) UpdateSettings(ctx context.Context, todosetID int64,
 		Service: "HillCharts", Operation: "UpdateSettings",
 		ResourceType: "hill_chart", IsMutation: true,
 		ResourceID: todosetID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -2,7 +2,11 @@ package basecamp
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
+	"sync"
 	"time"
 )
 
@@ -21,6 +25,11 @@ type HTTPOptions struct {
 	// Timeout is the request timeout (default: 30s).
 	Timeout time.Duration
 
+	// RequestTimeout, if positive, bounds each HTTP attempt with a context
+	// deadline, including reading its response body. A retry or the next
+	// page of a paginated call starts a fresh deadline. Zero disables it.
+	RequestTimeout time.Duration
+
 	// MaxRetries is the total attempt count for GET requests (default: 3,
 	// minimum 1 — NewClient panics on lower values). POST/PUT/DELETE requests
 	// make one attempt plus one retry after a successful token refresh.
@@ -30,8 +39,13 @@ type HTTPOptions struct {
 	BaseDelay time.Duration
 
 	// MaxJitter is the maximum random jitter to add to delays (default: 100ms).
+	// It is ignored when Jitter is set.
 	MaxJitter time.Duration
 
+	// Jitter, if set, computes retry delays in place of the default
+	// exponential backoff plus MaxJitter (see JitterStrategy).
+	Jitter JitterStrategy
+
 	// MaxPages is the maximum pages to fetch in GetAll (default: 10000).
 	MaxPages int
 
@@ -51,6 +65,29 @@ func DefaultHTTPOptions() HTTPOptions {
 	}
 }
 
+// Validate reports HTTP options that would make NewClient panic.
+// The returned error joins every problem found (see errors.Join); use
+// errors.Is with the ErrInvalid* sentinels to test for a specific one.
+func (o HTTPOptions) Validate() error {
+	var errs []error
+	if o.Timeout <= 0 {
+		errs = append(errs, ErrInvalidTimeout)
+	}
+	if o.MaxRetries < 1 {
+		// MaxRetries names the total attempt count used by the retry loops in
+		// doRequestURL and fetchAPIDownload. Zero attempts is always a
+		// misconfiguration; reject it here so both loops can assume >= 1.
+		errs = append(errs, ErrInvalidMaxRetries)
+	}
+	if o.MaxPages <= 0 {
+		errs = append(errs, ErrInvalidMaxPages)
+	}
+	if o.RequestTimeout < 0 {
+		errs = append(errs, fmt.Errorf("%w: request timeout %v", ErrInvalidTimeout, o.RequestTimeout))
+	}
+	return errors.Join(errs...)
+}
+
 // WithTimeout sets the HTTP request timeout.
 func WithTimeout(d time.Duration) ClientOption {
 	return func(c *Client) {
@@ -58,6 +95,16 @@ func WithTimeout(d time.Duration) ClientOption {
 	}
 }
 
+// WithRequestTimeout bounds each HTTP attempt to d, separately from the
+// client Timeout. A slow attempt fails with context.DeadlineExceeded and GET
+// requests retry it as a network error. Must not be negative (NewClient
+// panics otherwise).
+func WithRequestTimeout(d time.Duration) ClientOption {
+	return func(c *Client) {
+		c.httpOpts.RequestTimeout = d
+	}
+}
+
 // WithMaxRetries sets the total attempt count for GET requests.
 // Must be at least 1 (NewClient panics otherwise).
 func WithMaxRetries(n int) ClientOption {
@@ -66,6 +113,20 @@ func WithMaxRetries(n int) ClientOption {
 	}
 }
 
+// WithRetryMutationsWithIdempotencyKey lets POST, PUT, and DELETE requests
+// made through Client and AccountClient's Post, Put, and Delete methods retry
+// on 429 and 5xx gateway errors, like GETs, when their context carries an
+// idempotency key (see ContextWithIdempotencyKey). Mutations without a key are
+// still sent once.
+//
+// Service methods use the generated client's retry policy, which retries PUT
+// and DELETE but never POST; they send the Idempotency-Key header regardless.
+func WithRetryMutationsWithIdempotencyKey() ClientOption {
+	return func(c *Client) {
+		c.retryKeyedMutations = true
+	}
+}
+
 // WithBaseDelay sets the initial backoff delay.
 func WithBaseDelay(d time.Duration) ClientOption {
 	return func(c *Client) {
@@ -80,6 +141,15 @@ func WithMaxJitter(d time.Duration) ClientOption {
 	}
 }
 
+// WithJitterStrategy sets how retry delays are computed, replacing the
+// default exponential backoff plus MaxJitter. See FullJitter, EqualJitter,
+// and DecorrelatedJitter.
+func WithJitterStrategy(strategy JitterStrategy) ClientOption {
+	return func(c *Client) {
+		c.httpOpts.Jitter = strategy
+	}
+}
+
 // WithMaxPages sets the maximum pages to fetch in GetAll.
 func WithMaxPages(n int) ClientOption {
 	return func(c *Client) {
@@ -87,6 +157,41 @@ func WithMaxPages(n int) ClientOption {
 	}
 }
 
+// WithConcurrencyLimit bounds the number of requests made through Get, Post,
+// Put, Delete, and GetAll (on Client or AccountClient) that are in flight at
+// once. A request waits for a free slot, or fails with the context's error
+// if the context is done first. A slot is held for one attempt, not across
+// retry delays. n <= 0 removes the limit.
+//
+// Service methods are not bounded by this limit; use WithBulkhead for them.
+// A client made with Clone gets its own limit.
+func WithConcurrencyLimit(n int) ClientOption {
+	return func(c *Client) {
+		c.requestSlots = nil
+		if n > 0 {
+			c.requestSlots = make(chan struct{}, n)
+		}
+	}
+}
+
+// WithMaxResponseSize sets the maximum size in bytes of a successful response
+// body read by the client (default MaxResponseBodyBytes). Raise it to fetch
+// very large documents. Must be positive (NewClient panics otherwise).
+func WithMaxResponseSize(n int64) ClientOption {
+	return func(c *Client) {
+		c.maxResponseBytes = n
+	}
+}
+
+// WithMaxErrorBodySize sets the maximum size in bytes of an error response
+// body read by the client (default MaxErrorBodyBytes). Must be positive
+// (NewClient panics otherwise).
+func WithMaxErrorBodySize(n int64) ClientOption {
+	return func(c *Client) {
+		c.maxErrorBodyBytes = n
+	}
+}
+
 // WithTransport sets a custom HTTP transport.
 func WithTransport(t http.RoundTripper) ClientOption {
 	return func(c *Client) {
@@ -147,6 +252,11 @@ type loggingTransport struct {
 }
 
 // RoundTrip implements http.RoundTripper with logging and hooks.
+//
+// When the response has a body, OnRequestEnd is deferred until the body is
+// read to EOF or closed, so that RequestResult.ResponseSize can report the
+// number of body bytes read. Duration still measures the time to response
+// headers.
 func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 	// Call hooks before request
 	info := RequestInfo{
@@ -154,18 +264,23 @@ func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error)
 		URL:     req.URL.String(),
 		Attempt: attemptFromContext(req.Context()),
 	}
-	hookCtx := t.client.hooks.OnRequestStart(req.Context(), info)
+	ctx := req.Context()
+	if gater, ok := t.client.hooks.(RequestGatingHooks); ok {
+		var err error
+		if ctx, err = gater.OnRequestGate(ctx, info); err != nil {
+			return nil, &requestGateError{err: err}
+		}
+	}
+	hookCtx := t.client.hooks.OnRequestStart(ctx, info)
 	startTime := time.Now()
 
-	// Update request context with hook context for trace propagation
-	req = req.WithContext(hookCtx)
-
-	// Track result for hooks
-	var result RequestResult
-	defer func() {
-		result.Duration = time.Since(startTime)
-		t.client.hooks.OnRequestEnd(hookCtx, info, result)
-	}()
+	// Update request context with hook context for trace propagation, bounded
+	// by the per-attempt deadline when one is configured.
+	reqCtx, cancel := hookCtx, context.CancelFunc(func() {})
+	if d := t.client.httpOpts.RequestTimeout; d > 0 {
+		reqCtx, cancel = context.WithTimeout(hookCtx, d)
+	}
+	req = req.WithContext(reqCtx)
 
 	// Log request if logger is enabled
 	if t.client.logger != nil {
@@ -177,20 +292,75 @@ func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error)
 	resp, err := t.inner.RoundTrip(req)
 
 	// Record result
+	var result RequestResult
+	result.Duration = time.Since(startTime)
 	if err != nil {
+		cancel()
 		result.Error = err
-	} else {
-		result.StatusCode = resp.StatusCode
-		// Parse Retry-After header for 429/503 responses
-		if resp.StatusCode == 429 || resp.StatusCode == 503 {
-			result.RetryAfter = parseRetryAfter(resp.Header.Get("Retry-After"))
-		}
-		// Log response if logger is enabled
-		if t.client.logger != nil {
-			t.client.logger.Debug("http response",
-				"status", resp.StatusCode)
-		}
+		t.client.hooks.OnRequestEnd(hookCtx, info, result)
+		return resp, err
+	}
+
+	result.StatusCode = resp.StatusCode
+	// Parse Retry-After header for 429/503 responses
+	if resp.StatusCode == 429 || resp.StatusCode == 503 {
+		result.RetryAfter = parseRetryAfter(resp.Header.Get("Retry-After"))
 	}
+	// Log response if logger is enabled
+	if t.client.logger != nil {
+		t.client.logger.Debug("http response",
+			"status", resp.StatusCode)
+	}
+
+	if resp.Body == nil || resp.Body == http.NoBody {
+		cancel()
+		t.client.hooks.OnRequestEnd(hookCtx, info, result)
+		return resp, nil
+	}
+	// The deadline must outlive RoundTrip so the body can still be read;
+	// release it once the body is done.
+	resp.Body = &countingBody{
+		ReadCloser: resp.Body,
+		done: func(n int64) {
+			cancel()
+			result.ResponseSize = n
+			t.client.hooks.OnRequestEnd(hookCtx, info, result)
+		},
+	}
+	return resp, nil
+}
+
+// requestGateError marks a request rejected by OnRequestGate, so the client
+// returns the gate's error instead of retrying it as a network failure.
+// Permanent tells the generated client's retry loop the same thing.
+type requestGateError struct {
+	err error
+}
+
+func (e *requestGateError) Error() string   { return e.err.Error() }
+func (e *requestGateError) Unwrap() error   { return e.err }
+func (e *requestGateError) Permanent() bool { return true }
+
+// countingBody counts the bytes read from a response body and calls done
+// exactly once, at EOF or Close, with the total.
+type countingBody struct {
+	io.ReadCloser
+	n    int64
+	once sync.Once
+	done func(n int64)
+}
+
+func (b *countingBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.n += int64(n)
+	if err == io.EOF {
+		b.once.Do(func() { b.done(b.n) })
+	}
+	return n, err
+}
 
-	return resp, err
+func (b *countingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(func() { b.done(b.n) })
+	return err
 }
@@ -0,0 +1,41 @@
+package basecamp
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+)
+
+// idempotencyKeyHeader is the request header carrying an idempotency key.
+const idempotencyKeyHeader = "Idempotency-Key"
+
+// idempotencyKeyCtxKey is the context key for a request's idempotency key.
+type idempotencyKeyCtxKey struct{}
+
+// ContextWithIdempotencyKey returns a context that sends key as the
+// Idempotency-Key header on requests made with it. Use a fresh key per
+// logical mutation (see GenerateIdempotencyKey) and reuse it across retries
+// of that mutation so the server can deduplicate them.
+//
+// Combined with WithRetryMutationsWithIdempotencyKey, this lets the client
+// retry POST, PUT, and DELETE requests on transient failures.
+func ContextWithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyCtxKey{}, key)
+}
+
+// idempotencyKeyFromContext returns the idempotency key carried by ctx, or "".
+func idempotencyKeyFromContext(ctx context.Context) string {
+	key, _ := ctx.Value(idempotencyKeyCtxKey{}).(string)
+	return key
+}
+
+// GenerateIdempotencyKey returns a random (version 4) UUID suitable for use
+// with ContextWithIdempotencyKey.
+func GenerateIdempotencyKey() string {
+	var b [16]byte
+	// crypto/rand.Read never returns an error.
+	_, _ = rand.Read(b[:])
+	b[6] = (b[6] & 0x0f) | 0x40 // version 4
+	b[8] = (b[8] & 0x3f) | 0x80 // RFC 4122 variant
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
@@ -0,0 +1,127 @@
+package basecamp
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+var uuidV4Pattern = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+
+func TestGenerateIdempotencyKey(t *testing.T) {
+	a := GenerateIdempotencyKey()
+	b := GenerateIdempotencyKey()
+
+	for _, key := range []string{a, b} {
+		if !uuidV4Pattern.MatchString(key) {
+			t.Errorf("GenerateIdempotencyKey() = %q, want a version 4 UUID", key)
+		}
+	}
+	if a == b {
+		t.Errorf("GenerateIdempotencyKey() returned %q twice", a)
+	}
+}
+
+// testIdempotencyServer returns a client whose server fails the first
+// failures requests with 503, recording each Idempotency-Key header.
+func testIdempotencyServer(t *testing.T, failures int32, opts ...ClientOption) (*Client, *atomic.Int32, *[]string) {
+	t.Helper()
+	var calls atomic.Int32
+	var keys []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get("Idempotency-Key"))
+		if calls.Add(1) <= failures {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id":1}`))
+	}))
+	t.Cleanup(server.Close)
+
+	opts = append([]ClientOption{WithBaseDelay(time.Millisecond), WithMaxJitter(time.Millisecond)}, opts...)
+	client := NewClient(&Config{BaseURL: server.URL}, &StaticTokenProvider{Token: "test-token"}, opts...)
+	return client, &calls, &keys
+}
+
+func TestIdempotencyKey_HeaderSet(t *testing.T) {
+	client, _, keys := testIdempotencyServer(t, 0)
+	ctx := ContextWithIdempotencyKey(context.Background(), "key-123")
+
+	if _, err := client.Post(ctx, "/things.json", map[string]any{"name": "x"}); err != nil {
+		t.Fatalf("Post() error = %v", err)
+	}
+	if len(*keys) != 1 || (*keys)[0] != "key-123" {
+		t.Errorf("Idempotency-Key headers = %q, want [key-123]", *keys)
+	}
+}
+
+func TestIdempotencyKey_HeaderOnServiceMethods(t *testing.T) {
+	var got string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("Idempotency-Key")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(server.Close)
+	client := NewClient(&Config{BaseURL: server.URL}, &StaticTokenProvider{Token: "test-token"})
+
+	ctx := ContextWithIdempotencyKey(context.Background(), "key-456")
+	if err := client.ForAccount("99999").Recordings().Trash(ctx, 1); err != nil {
+		t.Fatalf("Trash() error = %v", err)
+	}
+	if got != "key-456" {
+		t.Errorf("Idempotency-Key = %q, want %q", got, "key-456")
+	}
+}
+
+func TestIdempotencyKey_RetriesMutationWhenEnabled(t *testing.T) {
+	client, calls, keys := testIdempotencyServer(t, 2, WithRetryMutationsWithIdempotencyKey())
+	ctx := ContextWithIdempotencyKey(context.Background(), "key-789")
+
+	resp, err := client.Post(ctx, "/things.json", map[string]any{"name": "x"})
+	if err != nil {
+		t.Fatalf("Post() error = %v", err)
+	}
+	if resp.StatusCode != http.StatusCreated {
+		t.Errorf("StatusCode = %d, want 201", resp.StatusCode)
+	}
+	if calls.Load() != 3 {
+		t.Errorf("calls = %d, want 3", calls.Load())
+	}
+	for i, key := range *keys {
+		if key != "key-789" {
+			t.Errorf("attempt %d Idempotency-Key = %q, want key-789", i+1, key)
+		}
+	}
+}
+
+func TestIdempotencyKey_NoRetryWithoutOption(t *testing.T) {
+	client, calls, _ := testIdempotencyServer(t, 1)
+	ctx := ContextWithIdempotencyKey(context.Background(), "key-1")
+
+	if _, err := client.Post(ctx, "/things.json", nil); err == nil {
+		t.Fatal("Post() error = nil, want 503 error")
+	}
+	if calls.Load() != 1 {
+		t.Errorf("calls = %d, want 1", calls.Load())
+	}
+}
+
+func TestIdempotencyKey_NoRetryWithoutKey(t *testing.T) {
+	client, calls, keys := testIdempotencyServer(t, 1, WithRetryMutationsWithIdempotencyKey())
+
+	if _, err := client.Post(context.Background(), "/things.json", nil); err == nil {
+		t.Fatal("Post() error = nil, want 503 error")
+	}
+	if calls.Load() != 1 {
+		t.Errorf("calls = %d, want 1", calls.Load())
+	}
+	if (*keys)[0] != "" {
+		t.Errorf("Idempotency-Key = %q, want none", (*keys)[0])
+	}
+}
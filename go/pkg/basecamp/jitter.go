@@ -0,0 +1,101 @@
+package basecamp
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// JitterStrategy computes the backoff delay before a retry. attempt is the
+// 1-based number of the attempt that just failed, and baseDelay is the
+// client's BaseDelay. Implementations must be safe for concurrent use.
+//
+// Without a strategy the client waits BaseDelay * 2^(attempt-1) plus a random
+// jitter of up to MaxJitter. The strategies below are the variants described
+// in the AWS Architecture Blog post "Exponential Backoff And Jitter".
+type JitterStrategy interface {
+	Jitter(baseDelay time.Duration, attempt int) time.Duration
+}
+
+// FullJitter waits a random duration in [0, baseDelay * 2^(attempt-1)).
+type FullJitter struct {
+	// Cap bounds the exponential delay before jitter is applied.
+	// Zero means no cap.
+	Cap time.Duration
+
+	int63n func(n int64) int64 // test hook; nil uses math/rand
+}
+
+// Jitter implements JitterStrategy.
+func (j FullJitter) Jitter(baseDelay time.Duration, attempt int) time.Duration {
+	return randDuration(j.int63n, exponentialDelay(baseDelay, attempt, j.Cap))
+}
+
+// EqualJitter waits half of baseDelay * 2^(attempt-1) plus a random duration
+// up to the other half, so the delay always grows with the attempt.
+type EqualJitter struct {
+	// Cap bounds the exponential delay before jitter is applied.
+	// Zero means no cap.
+	Cap time.Duration
+
+	int63n func(n int64) int64 // test hook; nil uses math/rand
+}
+
+// Jitter implements JitterStrategy.
+func (j EqualJitter) Jitter(baseDelay time.Duration, attempt int) time.Duration {
+	half := exponentialDelay(baseDelay, attempt, j.Cap) / 2
+	return half + randDuration(j.int63n, half)
+}
+
+// DecorrelatedJitter waits a random duration in [baseDelay, 3 * previous
+// delay), starting from baseDelay on the first attempt. The previous delay
+// is shared by every request made through the client, so use a pointer and
+// do not share one value between clients.
+type DecorrelatedJitter struct {
+	// Cap bounds the delay. Zero means no cap.
+	Cap time.Duration
+
+	mu     sync.Mutex
+	prev   time.Duration
+	int63n func(n int64) int64 // test hook; nil uses math/rand
+}
+
+// Jitter implements JitterStrategy.
+func (j *DecorrelatedJitter) Jitter(baseDelay time.Duration, attempt int) time.Duration {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if attempt <= 1 || j.prev < baseDelay {
+		j.prev = baseDelay
+	}
+	delay := baseDelay + randDuration(j.int63n, 3*j.prev-baseDelay)
+	if j.Cap > 0 && delay > j.Cap {
+		delay = j.Cap
+	}
+	j.prev = delay
+	return delay
+}
+
+// exponentialDelay returns baseDelay * 2^(attempt-1), bounded by limit when
+// limit is positive.
+func exponentialDelay(baseDelay time.Duration, attempt int, limit time.Duration) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+	delay := baseDelay * time.Duration(1<<(attempt-1))
+	if limit > 0 && delay > limit {
+		delay = limit
+	}
+	return delay
+}
+
+// randDuration returns a random duration in [0, n), or 0 when n <= 0.
+func randDuration(int63n func(int64) int64, n time.Duration) time.Duration {
+	if n <= 0 {
+		return 0
+	}
+	if int63n == nil {
+		int63n = rand.Int63n // #nosec G404 -- jitter doesn't need cryptographic randomness
+	}
+	return time.Duration(int63n(int64(n)))
+}
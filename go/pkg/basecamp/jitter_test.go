@@ -0,0 +1,104 @@
+package basecamp
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+)
+
+const jitterIterations = 1000
+
+func seededInt63n(seed int64) func(int64) int64 {
+	return rand.New(rand.NewSource(seed)).Int63n // #nosec G404 -- deterministic test randomness
+}
+
+func TestFullJitter_Bounds(t *testing.T) {
+	j := FullJitter{int63n: seededInt63n(1)}
+	base := 100 * time.Millisecond
+
+	for attempt := 1; attempt <= 5; attempt++ {
+		upper := base * time.Duration(1<<(attempt-1))
+		for range jitterIterations {
+			d := j.Jitter(base, attempt)
+			if d < 0 || d >= upper {
+				t.Fatalf("attempt %d: delay %v outside [0, %v)", attempt, d, upper)
+			}
+		}
+	}
+}
+
+func TestEqualJitter_Bounds(t *testing.T) {
+	j := EqualJitter{int63n: seededInt63n(2)}
+	base := 100 * time.Millisecond
+
+	for attempt := 1; attempt <= 5; attempt++ {
+		upper := base * time.Duration(1<<(attempt-1))
+		for range jitterIterations {
+			d := j.Jitter(base, attempt)
+			if d < upper/2 || d >= upper {
+				t.Fatalf("attempt %d: delay %v outside [%v, %v)", attempt, d, upper/2, upper)
+			}
+		}
+	}
+}
+
+func TestDecorrelatedJitter_Bounds(t *testing.T) {
+	j := &DecorrelatedJitter{Cap: 5 * time.Second, int63n: seededInt63n(3)}
+	base := 100 * time.Millisecond
+
+	for range jitterIterations {
+		prev := base
+		for attempt := 1; attempt <= 5; attempt++ {
+			d := j.Jitter(base, attempt)
+			if d < base || d > j.Cap {
+				t.Fatalf("attempt %d: delay %v outside [%v, %v]", attempt, d, base, j.Cap)
+			}
+			if d >= 3*prev && d != j.Cap {
+				t.Fatalf("attempt %d: delay %v not below 3x previous delay %v", attempt, d, prev)
+			}
+			prev = d
+		}
+	}
+}
+
+func TestJitter_Cap(t *testing.T) {
+	base := time.Second
+	limit := 3 * time.Second
+
+	full := FullJitter{Cap: limit, int63n: seededInt63n(4)}
+	equal := EqualJitter{Cap: limit, int63n: seededInt63n(5)}
+	for range jitterIterations {
+		if d := full.Jitter(base, 10); d >= limit {
+			t.Fatalf("FullJitter delay %v not below cap %v", d, limit)
+		}
+		if d := equal.Jitter(base, 10); d < limit/2 || d >= limit {
+			t.Fatalf("EqualJitter delay %v outside [%v, %v)", d, limit/2, limit)
+		}
+	}
+}
+
+func TestWithJitterStrategy(t *testing.T) {
+	client := NewClient(DefaultConfig(), &StaticTokenProvider{Token: "test-token"},
+		WithBaseDelay(10*time.Millisecond),
+		WithJitterStrategy(EqualJitter{int63n: func(int64) int64 { return 0 }}),
+	)
+
+	for attempt, want := range map[int]time.Duration{1: 5 * time.Millisecond, 3: 20 * time.Millisecond} {
+		if got := client.backoffDelay(attempt); got != want {
+			t.Errorf("backoffDelay(%d) = %v, want %v", attempt, got, want)
+		}
+	}
+}
+
+func TestBackoffDelay_DefaultWithoutStrategy(t *testing.T) {
+	client := NewClient(DefaultConfig(), &StaticTokenProvider{Token: "test-token"},
+		WithBaseDelay(10*time.Millisecond),
+		WithMaxJitter(5*time.Millisecond),
+	)
+
+	for range jitterIterations {
+		if d := client.backoffDelay(2); d < 20*time.Millisecond || d >= 25*time.Millisecond {
+			t.Fatalf("backoffDelay(2) = %v, want within [20ms, 25ms)", d)
+		}
+	}
+}
@@ -52,6 +52,7 @@ func (s *LineupService) CreateMarker(ctx context.Context, req *CreateMarkerReque
 	op := OperationInfo{
 		Service: "Lineup", Operation: "CreateMarker",
 		ResourceType: "lineup_marker", IsMutation: true,
+		AccountID: s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -90,6 +91,7 @@ func (s *LineupService) UpdateMarker(ctx context.Context, markerID int64, req *U
 		Service: "Lineup", Operation: "UpdateMarker",
 		ResourceType: "lineup_marker", IsMutation: true,
 		ResourceID: markerID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -127,6 +129,7 @@ func (s *LineupService) DeleteMarker(ctx context.Context, markerID int64) (err e
 		Service: "Lineup", Operation: "DeleteMarker",
 		ResourceType: "lineup_marker", IsMutation: true,
 		ResourceID: markerID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -149,6 +152,7 @@ func (s *LineupService) ListMarkers(ctx context.Context) (result *ListMarkersRes
 	op := OperationInfo{
 		Service: "Lineup", Operation: "ListMarkers",
 		ResourceType: "lineup_marker", IsMutation: false,
+		AccountID: s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -0,0 +1,45 @@
+// Package markdown converts Basecamp rich text to Markdown.
+//
+// It is a separate package so that the HTML-to-Markdown converter is only
+// linked into programs that use it.
+//
+// # Usage
+//
+//	import (
+//	    "github.com/basecamp/basecamp-sdk/go/pkg/basecamp"
+//	    "github.com/basecamp/basecamp-sdk/go/pkg/basecamp/markdown"
+//	)
+//
+//	md, err := markdown.ExportDocument(ctx, account.Documents(), documentID)
+package markdown
+
+import (
+	"context"
+	"fmt"
+
+	htmltomarkdown "github.com/JohannesKaufmann/html-to-markdown/v2"
+
+	"github.com/basecamp/basecamp-sdk/go/pkg/basecamp"
+)
+
+// FromHTML converts Basecamp rich text (HTML, as found in Document Content,
+// Message Content, and so on) to CommonMark. Headings, emphasis, links, code
+// blocks, and lists are preserved; the <div> wrappers Basecamp puts around
+// paragraphs become paragraph breaks.
+func FromHTML(html string) (string, error) {
+	return htmltomarkdown.ConvertString(html)
+}
+
+// ExportDocument returns a document's content converted from HTML to
+// CommonMark (see FromHTML). Hooks observe the Documents.Get call.
+func ExportDocument(ctx context.Context, documents *basecamp.DocumentsService, documentID int64) (string, error) {
+	doc, err := documents.Get(ctx, documentID)
+	if err != nil {
+		return "", err
+	}
+	md, err := FromHTML(doc.Content)
+	if err != nil {
+		return "", fmt.Errorf("failed to convert document %d to markdown: %w", documentID, err)
+	}
+	return md, nil
+}
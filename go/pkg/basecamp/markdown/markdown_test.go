@@ -0,0 +1,70 @@
+package markdown
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/basecamp/basecamp-sdk/go/pkg/basecamp"
+)
+
+func TestFromHTML(t *testing.T) {
+	tests := []struct {
+		name string
+		html string
+		want string
+	}{
+		{"heading", `<div><h1>Launch plan</h1></div>`, "# Launch plan"},
+		{"bold and italic", `<div>Some <strong>bold</strong> and <em>italic</em> text</div>`, "Some **bold** and *italic* text"},
+		{"link", `<div><a href="https://basecamp.com">Basecamp</a></div>`, "[Basecamp](https://basecamp.com)"},
+		{"code block", "<pre>func main() {\n\tfmt.Println(\"hi\")\n}</pre>", "```\nfunc main() {\n\tfmt.Println(\"hi\")\n}\n```"},
+		{"unordered list", `<ul><li>One</li><li>Two</li></ul>`, "- One\n- Two"},
+		{"ordered list", `<ol><li>First</li><li>Second</li></ol>`, "1. First\n2. Second"},
+		{"div paragraphs", `<div>Line one<br>Line two</div><div><br></div><div>Para</div>`, "Line one  \nLine two\n\nPara"},
+		{"empty", ``, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FromHTML(tt.html)
+			if err != nil {
+				t.Fatalf("FromHTML() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("FromHTML() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExportDocument(t *testing.T) {
+	var receivedPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receivedPath = r.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"id":      300,
+			"title":   "Launch plan",
+			"content": `<div><h2>Goals</h2></div><div>Ship <strong>v2</strong></div><ul><li>Docs</li><li>Tests</li></ul>`,
+		})
+	}))
+	defer server.Close()
+
+	cfg := basecamp.DefaultConfig()
+	cfg.BaseURL = server.URL
+	client := basecamp.NewClient(cfg, &basecamp.StaticTokenProvider{Token: "test-token"})
+
+	md, err := ExportDocument(context.Background(), client.ForAccount("12345").Documents(), 300)
+	if err != nil {
+		t.Fatalf("ExportDocument() error = %v", err)
+	}
+	if receivedPath != "/12345/documents/300" {
+		t.Errorf("expected path /12345/documents/300, got %s", receivedPath)
+	}
+	want := "## Goals\n\nShip **v2**\n\n- Docs\n- Tests"
+	if md != want {
+		t.Errorf("ExportDocument() = %q, want %q", md, want)
+	}
+}
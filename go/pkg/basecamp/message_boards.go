@@ -45,6 +45,7 @@ func (s *MessageBoardsService) Get(ctx context.Context, boardID int64) (result *
 		Service: "MessageBoards", Operation: "Get",
 		ResourceType: "message_board", IsMutation: false,
 		ResourceID: boardID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
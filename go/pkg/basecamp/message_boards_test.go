@@ -1,7 +1,10 @@
 package basecamp
 
 import (
+	"context"
 	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"testing"
@@ -98,3 +101,54 @@ func TestMessageBoard_UnmarshalGet(t *testing.T) {
 		t.Error("expected Creator.Admin to be true")
 	}
 }
+
+func TestMessageBoardsService_Get(t *testing.T) {
+	fixture := loadMessageBoardsFixture(t, "get.json")
+	var receivedPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receivedPath = r.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(fixture)
+	}))
+	t.Cleanup(server.Close)
+
+	cfg := DefaultConfig()
+	cfg.BaseURL = server.URL
+	hooks := &recordingHooks{}
+	client := NewClient(cfg, &StaticTokenProvider{Token: "test-token"}, WithHooks(hooks))
+
+	board, err := client.ForAccount("99999").MessageBoards().Get(context.Background(), 1069479338)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if receivedPath != "/99999/message_boards/1069479338" {
+		t.Errorf("unexpected path: %q", receivedPath)
+	}
+
+	var want MessageBoard
+	if err := json.Unmarshal(fixture, &want); err != nil {
+		t.Fatalf("failed to unmarshal get.json: %v", err)
+	}
+	if board.ID != want.ID || board.Title != want.Title || board.Type != want.Type || board.Status != want.Status {
+		t.Errorf("board = %+v, want %+v", board, want)
+	}
+	if board.MessagesCount != want.MessagesCount || board.MessagesURL != want.MessagesURL {
+		t.Errorf("messages: got (%d, %q), want (%d, %q)", board.MessagesCount, board.MessagesURL, want.MessagesCount, want.MessagesURL)
+	}
+	if !board.CreatedAt.Equal(want.CreatedAt) || !board.UpdatedAt.Equal(want.UpdatedAt) {
+		t.Errorf("timestamps: got (%v, %v), want (%v, %v)", board.CreatedAt, board.UpdatedAt, want.CreatedAt, want.UpdatedAt)
+	}
+	if board.Bucket == nil || board.Bucket.ID != want.Bucket.ID {
+		t.Errorf("bucket = %+v, want %+v", board.Bucket, want.Bucket)
+	}
+	if board.Creator == nil || board.Creator.ID != want.Creator.ID {
+		t.Errorf("creator = %+v, want %+v", board.Creator, want.Creator)
+	}
+
+	if len(hooks.opStartCalls) != 1 || len(hooks.opEndCalls) != 1 {
+		t.Fatalf("expected 1 operation start/end, got %d/%d", len(hooks.opStartCalls), len(hooks.opEndCalls))
+	}
+	if op := hooks.opStartCalls[0]; op.Service != "MessageBoards" || op.Operation != "Get" || op.ResourceID != 1069479338 {
+		t.Errorf("unexpected operation: %+v", op)
+	}
+}
@@ -74,6 +74,7 @@ func (s *MessageTypesService) List(ctx context.Context, opts *MessageTypeListOpt
 	op := OperationInfo{
 		Service: "MessageTypes", Operation: "List",
 		ResourceType: "message_type", IsMutation: false,
+		AccountID: s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -94,6 +95,7 @@ func (s *MessageTypesService) List(ctx context.Context, opts *MessageTypeListOpt
 
 	// Capture total count from X-Total-Count header
 	totalCount := parseTotalCount(resp.HTTPResponse)
+	totalPages := parseTotalPages(resp.HTTPResponse, firstPageLen(resp.JSON200))
 
 	// Parse first page
 	var types []MessageType
@@ -105,7 +107,7 @@ func (s *MessageTypesService) List(ctx context.Context, opts *MessageTypeListOpt
 
 	// Handle single page fetch (--page flag)
 	if opts != nil && opts.Page > 0 {
-		return &MessageTypeListResult{MessageTypes: types, Meta: ListMeta{TotalCount: totalCount}}, nil
+		return &MessageTypeListResult{MessageTypes: types, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages}}, nil
 	}
 
 	// Determine limit: 0 = all (no limit)
@@ -120,7 +122,7 @@ func (s *MessageTypesService) List(ctx context.Context, opts *MessageTypeListOpt
 
 	// Check if we already have enough items
 	if limit > 0 && len(types) >= limit {
-		return &MessageTypeListResult{MessageTypes: types[:limit], Meta: ListMeta{TotalCount: totalCount, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(types), limit)}}, nil
+		return &MessageTypeListResult{MessageTypes: types[:limit], Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(types), limit)}}, nil
 	}
 
 	// Follow pagination via Link headers
@@ -138,7 +140,7 @@ func (s *MessageTypesService) List(ctx context.Context, opts *MessageTypeListOpt
 		types = append(types, messageTypeFromGenerated(gt))
 	}
 
-	return &MessageTypeListResult{MessageTypes: types, Meta: ListMeta{TotalCount: totalCount, Truncated: truncated}}, nil
+	return &MessageTypeListResult{MessageTypes: types, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: truncated}}, nil
 }
 
 // Get returns a message type by ID.
@@ -147,6 +149,7 @@ func (s *MessageTypesService) Get(ctx context.Context, typeID int64) (result *Me
 		Service: "MessageTypes", Operation: "Get",
 		ResourceType: "message_type", IsMutation: false,
 		ResourceID: typeID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -179,6 +182,7 @@ func (s *MessageTypesService) Create(ctx context.Context, req *CreateMessageType
 	op := OperationInfo{
 		Service: "MessageTypes", Operation: "Create",
 		ResourceType: "message_type", IsMutation: true,
+		AccountID: s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -226,6 +230,7 @@ func (s *MessageTypesService) Update(ctx context.Context, typeID int64, req *Upd
 		Service: "MessageTypes", Operation: "Update",
 		ResourceType: "message_type", IsMutation: true,
 		ResourceID: typeID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -271,6 +276,7 @@ func (s *MessageTypesService) Delete(ctx context.Context, typeID int64) (err err
 		Service: "MessageTypes", Operation: "Delete",
 		ResourceType: "message_type", IsMutation: true,
 		ResourceID: typeID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
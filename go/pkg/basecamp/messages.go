@@ -116,6 +116,7 @@ func (s *MessagesService) List(ctx context.Context, boardID int64, opts *Message
 		Service: "Messages", Operation: "List",
 		ResourceType: "message", IsMutation: false,
 		ResourceID: boardID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -148,6 +149,7 @@ func (s *MessagesService) List(ctx context.Context, boardID int64, opts *Message
 
 	// Capture total count from X-Total-Count header (first page only)
 	totalCount := parseTotalCount(resp.HTTPResponse)
+	totalPages := parseTotalPages(resp.HTTPResponse, firstPageLen(resp.JSON200))
 
 	// Parse first page
 	var messages []Message
@@ -159,7 +161,7 @@ func (s *MessagesService) List(ctx context.Context, boardID int64, opts *Message
 
 	// Handle single page fetch (--page flag)
 	if opts != nil && opts.Page > 0 {
-		return &MessageListResult{Messages: messages, Meta: ListMeta{TotalCount: totalCount}}, nil
+		return &MessageListResult{Messages: messages, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages}}, nil
 	}
 
 	// Determine limit: 0 = default (100), -1 = unlimited, >0 = specific limit
@@ -174,7 +176,7 @@ func (s *MessagesService) List(ctx context.Context, boardID int64, opts *Message
 
 	// Check if we already have enough items
 	if limit > 0 && len(messages) >= limit {
-		return &MessageListResult{Messages: messages[:limit], Meta: ListMeta{TotalCount: totalCount, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(messages), limit)}}, nil
+		return &MessageListResult{Messages: messages[:limit], Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(messages), limit)}}, nil
 	}
 
 	// Follow pagination via Link headers (uses absolute URLs from API, no path construction)
@@ -192,7 +194,18 @@ func (s *MessagesService) List(ctx context.Context, boardID int64, opts *Message
 		messages = append(messages, messageFromGenerated(gm))
 	}
 
-	return &MessageListResult{Messages: messages, Meta: ListMeta{TotalCount: totalCount, Truncated: truncated}}, nil
+	return &MessageListResult{Messages: messages, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: truncated}}, nil
+}
+
+// ListAll returns every message on a message board, following Link headers
+// across pages (subject to the client's MaxPages cap). It is List with
+// Limit: -1, so hooks observe the Messages.List operation.
+func (s *MessagesService) ListAll(ctx context.Context, boardID int64) ([]Message, error) {
+	result, err := s.List(ctx, boardID, &MessageListOptions{Limit: -1})
+	if err != nil {
+		return nil, err
+	}
+	return result.Messages, nil
 }
 
 // Get returns a message by ID.
@@ -201,6 +214,7 @@ func (s *MessagesService) Get(ctx context.Context, messageID int64) (result *Mes
 		Service: "Messages", Operation: "Get",
 		ResourceType: "message", IsMutation: false,
 		ResourceID: messageID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -234,6 +248,7 @@ func (s *MessagesService) Create(ctx context.Context, boardID int64, req *Create
 		Service: "Messages", Operation: "Create",
 		ResourceType: "message", IsMutation: true,
 		ResourceID: boardID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -283,6 +298,7 @@ func (s *MessagesService) Update(ctx context.Context, messageID int64, req *Upda
 		Service: "Messages", Operation: "Update",
 		ResourceType: "message", IsMutation: true,
 		ResourceID: messageID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -334,6 +350,7 @@ func (s *MessagesService) Pin(ctx context.Context, messageID int64) (err error)
 		Service: "Messages", Operation: "Pin",
 		ResourceType: "message", IsMutation: true,
 		ResourceID: messageID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -357,6 +374,7 @@ func (s *MessagesService) Unpin(ctx context.Context, messageID int64) (err error
 		Service: "Messages", Operation: "Unpin",
 		ResourceType: "message", IsMutation: true,
 		ResourceID: messageID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -381,6 +399,7 @@ func (s *MessagesService) Trash(ctx context.Context, messageID int64) (err error
 		Service: "Messages", Operation: "Trash",
 		ResourceType: "message", IsMutation: true,
 		ResourceID: messageID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -405,6 +424,7 @@ func (s *MessagesService) Archive(ctx context.Context, messageID int64) (err err
 		Service: "Messages", Operation: "Archive",
 		ResourceType: "message", IsMutation: true,
 		ResourceID: messageID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -428,6 +448,7 @@ func (s *MessagesService) Unarchive(ctx context.Context, messageID int64) (err e
 		Service: "Messages", Operation: "Unarchive",
 		ResourceType: "message", IsMutation: true,
 		ResourceID: messageID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -3,6 +3,7 @@ package basecamp
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"os"
@@ -461,6 +462,37 @@ func TestMessagesService_List_NoSortDirection(t *testing.T) {
 	}
 }
 
+func TestMessagesService_ListAll(t *testing.T) {
+	var requests int
+	svc := testMessagesServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		page := r.URL.Query().Get("page")
+		if page == "" {
+			page = "1"
+		}
+		if page != "3" {
+			next := map[string]string{"1": "2", "2": "3"}[page]
+			w.Header().Set("Link", fmt.Sprintf(`<http://%s/99999/message_boards/200/messages.json?page=%s>; rel="next"`, r.Host, next))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `[{"id":%s01,"subject":"page %s"},{"id":%s02,"subject":"page %s"}]`, page, page, page, page)
+	})
+
+	messages, err := svc.ListAll(context.Background(), 200)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if requests != 3 {
+		t.Errorf("expected 3 requests, got %d", requests)
+	}
+	if len(messages) != 6 {
+		t.Fatalf("expected 6 messages, got %d", len(messages))
+	}
+	if messages[0].ID != 101 || messages[5].ID != 302 {
+		t.Errorf("unexpected message IDs: first %d, last %d", messages[0].ID, messages[5].ID)
+	}
+}
+
 func TestUpdateMessageRequest_MarshalPartial(t *testing.T) {
 	// Test with only some fields
 	req := UpdateMessageRequest{
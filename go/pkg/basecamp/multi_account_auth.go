@@ -0,0 +1,146 @@
+package basecamp
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// accountIDKey is the context key for the Basecamp account ID.
+type accountIDKey struct{}
+
+// WithAccountID returns a context carrying the given Basecamp account ID.
+// The client sets this automatically for account-scoped requests, so most
+// callers never need it.
+func WithAccountID(ctx context.Context, accountID string) context.Context {
+	return context.WithValue(ctx, accountIDKey{}, accountID)
+}
+
+// AccountIDFromContext returns the Basecamp account ID carried by ctx,
+// or an empty string if there is none.
+func AccountIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(accountIDKey{}).(string)
+	return id
+}
+
+// withRequestAccountID returns ctx carrying the account ID from req's path
+// (the first segment after the base URL path), unless ctx already has one.
+func (c *Client) withRequestAccountID(ctx context.Context, req *http.Request) context.Context {
+	if AccountIDFromContext(ctx) != "" || req == nil || req.URL == nil {
+		return ctx
+	}
+	path := req.URL.Path
+	if base, err := url.Parse(c.cfg.BaseURL); err == nil {
+		path = strings.TrimPrefix(path, strings.TrimSuffix(base.Path, "/"))
+	}
+	segment, _, _ := strings.Cut(strings.TrimPrefix(path, "/"), "/")
+	if segment == "" || strings.Trim(segment, "0123456789") != "" {
+		return ctx
+	}
+	return WithAccountID(ctx, segment)
+}
+
+// MultiAccountAuthManager is a TokenProvider that keeps separate OAuth
+// credentials for each Basecamp account and picks the right one using the
+// account ID in the request context.
+//
+// Example:
+//
+//	auth := basecamp.NewMultiAccountAuthManager(cfg, http.DefaultClient, store)
+//	_ = auth.AddAccount("12345", credsA)
+//	_ = auth.AddAccount("67890", credsB)
+//	client := basecamp.NewClient(cfg, auth)
+//	client.ForAccount("12345").Projects().List(ctx, nil) // uses credsA
+type MultiAccountAuthManager struct {
+	cfg        *Config
+	store      *CredentialStore
+	httpClient *http.Client
+
+	mu       sync.RWMutex
+	managers map[string]*AuthManager
+}
+
+// Ensure MultiAccountAuthManager implements TokenProvider at compile time.
+var _ TokenProvider = (*MultiAccountAuthManager)(nil)
+
+// NewMultiAccountAuthManager creates a multi-account auth manager.
+// Credentials are saved in store under a per-account key derived from cfg.BaseURL.
+func NewMultiAccountAuthManager(cfg *Config, httpClient *http.Client, store *CredentialStore) *MultiAccountAuthManager {
+	return &MultiAccountAuthManager{
+		cfg:        cfg,
+		store:      store,
+		httpClient: httpClient,
+		managers:   make(map[string]*AuthManager),
+	}
+}
+
+// AddAccount stores creds for accountID and starts using them for that
+// account's requests. Adding an existing account replaces its credentials.
+func (m *MultiAccountAuthManager) AddAccount(accountID string, creds *Credentials) error {
+	if accountID == "" {
+		return ErrUsage("account ID is required")
+	}
+	if creds == nil {
+		return ErrUsage("credentials are required")
+	}
+
+	accountCfg := *m.cfg
+	accountCfg.BaseURL = m.accountOrigin(accountID)
+	if err := m.store.Save(accountCfg.BaseURL, creds); err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.managers[accountID] = NewAuthManagerWithStore(&accountCfg, m.httpClient, m.store)
+	return nil
+}
+
+// RemoveAccount forgets accountID and deletes its stored credentials.
+func (m *MultiAccountAuthManager) RemoveAccount(accountID string) error {
+	m.mu.Lock()
+	manager, ok := m.managers[accountID]
+	delete(m.managers, accountID)
+	m.mu.Unlock()
+
+	if !ok {
+		return nil
+	}
+	return manager.Logout()
+}
+
+// AccessToken returns a valid access token for the account in ctx,
+// refreshing it if needed. It returns an auth error wrapping
+// ErrNoCredentials if ctx has no account ID or the account was never added.
+func (m *MultiAccountAuthManager) AccessToken(ctx context.Context) (string, error) {
+	accountID := AccountIDFromContext(ctx)
+	manager := m.manager(accountID)
+	if manager == nil {
+		return "", &Error{
+			Code:    CodeAuth,
+			Message: fmt.Sprintf("No credentials for account %q", accountID),
+			Cause:   ErrNoCredentials,
+		}
+	}
+	return manager.AccessToken(ctx)
+}
+
+// IsAuthenticated reports whether accountID has stored credentials.
+func (m *MultiAccountAuthManager) IsAuthenticated(accountID string) bool {
+	manager := m.manager(accountID)
+	return manager != nil && manager.IsAuthenticated()
+}
+
+func (m *MultiAccountAuthManager) manager(accountID string) *AuthManager {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.managers[accountID]
+}
+
+// accountOrigin is the credential store key for an account.
+func (m *MultiAccountAuthManager) accountOrigin(accountID string) string {
+	return NormalizeBaseURL(m.cfg.BaseURL) + "/" + accountID
+}
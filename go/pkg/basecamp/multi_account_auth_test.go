@@ -0,0 +1,135 @@
+package basecamp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+func newTestMultiAccountAuthManager(t *testing.T) *MultiAccountAuthManager {
+	t.Helper()
+	t.Setenv("BASECAMP_TOKEN", "")
+	store := &CredentialStore{useKeyring: false, fallbackDir: t.TempDir()}
+	return NewMultiAccountAuthManager(&Config{BaseURL: "https://3.basecampapi.com"}, &http.Client{}, store)
+}
+
+func TestMultiAccountAuthManager_SelectsAccountFromContext(t *testing.T) {
+	m := newTestMultiAccountAuthManager(t)
+	if err := m.AddAccount("111", &Credentials{AccessToken: "token-111", ExpiresAt: 9999999999}); err != nil {
+		t.Fatalf("AddAccount: %v", err)
+	}
+	if err := m.AddAccount("222", &Credentials{AccessToken: "token-222", ExpiresAt: 9999999999}); err != nil {
+		t.Fatalf("AddAccount: %v", err)
+	}
+
+	for _, id := range []string{"111", "222"} {
+		token, err := m.AccessToken(WithAccountID(context.Background(), id))
+		if err != nil {
+			t.Fatalf("AccessToken(%s): %v", id, err)
+		}
+		if token != "token-"+id {
+			t.Errorf("AccessToken(%s) = %q, want %q", id, token, "token-"+id)
+		}
+	}
+
+	if !m.IsAuthenticated("111") {
+		t.Error("IsAuthenticated(111) = false, want true")
+	}
+	if m.IsAuthenticated("333") {
+		t.Error("IsAuthenticated(333) = true, want false")
+	}
+}
+
+func TestMultiAccountAuthManager_MissingAccount(t *testing.T) {
+	m := newTestMultiAccountAuthManager(t)
+
+	for _, ctx := range []context.Context{
+		context.Background(),
+		WithAccountID(context.Background(), "404"),
+	} {
+		_, err := m.AccessToken(ctx)
+		if !errors.Is(err, ErrNoCredentials) {
+			t.Errorf("expected ErrNoCredentials, got %v", err)
+		}
+		if apiErr, ok := errors.AsType[*Error](err); !ok || apiErr.Code != CodeAuth {
+			t.Errorf("expected auth error, got %v", err)
+		}
+	}
+}
+
+func TestMultiAccountAuthManager_RemoveAccount(t *testing.T) {
+	m := newTestMultiAccountAuthManager(t)
+	_ = m.AddAccount("111", &Credentials{AccessToken: "token-111", ExpiresAt: 9999999999})
+
+	if err := m.RemoveAccount("111"); err != nil {
+		t.Fatalf("RemoveAccount: %v", err)
+	}
+	if m.IsAuthenticated("111") {
+		t.Error("account should be gone after RemoveAccount")
+	}
+	if _, err := m.store.Load("https://3.basecampapi.com/111"); err == nil {
+		t.Error("stored credentials should be deleted")
+	}
+	if err := m.RemoveAccount("111"); err != nil {
+		t.Errorf("removing an unknown account should be a no-op, got %v", err)
+	}
+}
+
+func TestMultiAccountAuthManager_ConcurrentAccess(t *testing.T) {
+	m := newTestMultiAccountAuthManager(t)
+	ids := []string{"1", "2", "3", "4"}
+	for _, id := range ids {
+		_ = m.AddAccount(id, &Credentials{AccessToken: "token-" + id, ExpiresAt: 9999999999})
+	}
+
+	var wg sync.WaitGroup
+	for i := range 100 {
+		id := ids[i%len(ids)]
+		wg.Go(func() {
+			token, err := m.AccessToken(WithAccountID(context.Background(), id))
+			if err != nil || token != "token-"+id {
+				t.Errorf("AccessToken(%s) = %q, %v", id, token, err)
+			}
+		})
+		wg.Go(func() { _ = m.IsAuthenticated(id) })
+	}
+	wg.Go(func() { _ = m.AddAccount("5", &Credentials{AccessToken: "token-5", ExpiresAt: 9999999999}) })
+	wg.Wait()
+}
+
+func TestMultiAccountAuthManager_ClientUsesRequestAccount(t *testing.T) {
+	var mu sync.Mutex
+	seen := map[string]string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		seen[r.URL.Path] = r.Header.Get("Authorization")
+		mu.Unlock()
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(server.Close)
+
+	m := newTestMultiAccountAuthManager(t)
+	_ = m.AddAccount("111", &Credentials{AccessToken: "token-111", ExpiresAt: 9999999999})
+	_ = m.AddAccount("222", &Credentials{AccessToken: "token-222", ExpiresAt: 9999999999})
+
+	cfg := DefaultConfig()
+	cfg.BaseURL = server.URL
+	client := NewClient(cfg, m)
+
+	for _, id := range []string{"111", "222"} {
+		if err := client.ForAccount(id).Recordings().Trash(context.Background(), 1); err != nil {
+			t.Fatalf("Trash via account %s: %v", id, err)
+		}
+	}
+
+	for _, id := range []string{"111", "222"} {
+		path := fmt.Sprintf("/%s/recordings/1/status/trashed.json", id)
+		if got, want := seen[path], "Bearer token-"+id; got != want {
+			t.Errorf("Authorization for %s = %q, want %q", path, got, want)
+		}
+	}
+}
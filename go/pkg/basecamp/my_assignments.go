@@ -69,6 +69,7 @@ func (s *MyAssignmentsService) Get(ctx context.Context) (result *MyAssignmentsRe
 	op := OperationInfo{
 		Service: "MyAssignments", Operation: "Get",
 		ResourceType: "my_assignment", IsMutation: false,
+		AccountID: s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -100,6 +101,7 @@ func (s *MyAssignmentsService) Completed(ctx context.Context) (result []MyAssign
 	op := OperationInfo{
 		Service: "MyAssignments", Operation: "Completed",
 		ResourceType: "my_assignment", IsMutation: false,
+		AccountID: s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -132,6 +134,7 @@ func (s *MyAssignmentsService) Due(ctx context.Context, scope string) (result []
 	op := OperationInfo{
 		Service: "MyAssignments", Operation: "Due",
 		ResourceType: "my_assignment", IsMutation: false,
+		AccountID: s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
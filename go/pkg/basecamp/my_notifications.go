@@ -90,6 +90,7 @@ func (s *MyNotificationsService) Get(ctx context.Context, page int32) (result *N
 	op := OperationInfo{
 		Service: "MyNotifications", Operation: "Get",
 		ResourceType: "notification", IsMutation: false,
+		AccountID: s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -133,6 +134,7 @@ func (s *MyNotificationsService) MarkAsRead(ctx context.Context, readables []str
 	op := OperationInfo{
 		Service: "MyNotifications", Operation: "MarkAsRead",
 		ResourceType: "notification", IsMutation: true,
+		AccountID: s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -0,0 +1,171 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Device flow errors (RFC 8628 §3.5). Match them with errors.Is.
+var (
+	// ErrDeviceCodeExpired is returned when the device code expires before
+	// the user completes authorization.
+	ErrDeviceCodeExpired = errors.New("device code expired")
+	// ErrAccessDenied is returned when the user denies the authorization request.
+	ErrAccessDenied = errors.New("access denied")
+)
+
+// defaultDeviceInterval is the polling interval in seconds used when the
+// authorization server omits one (RFC 8628 §3.2).
+const defaultDeviceInterval = 5
+
+// slowDownIncrement is how many seconds a slow_down response adds to the
+// polling interval (RFC 8628 §3.5).
+const slowDownIncrement = 5
+
+// DeviceFlowRequest contains parameters for the OAuth 2.0 device authorization
+// grant (RFC 8628). The endpoints usually come from a discovered Config.
+type DeviceFlowRequest struct {
+	DeviceAuthorizationEndpoint string
+	TokenEndpoint               string
+	ClientID                    string
+	ClientSecret                string
+	Scope                       string
+}
+
+// DeviceCode is a device authorization response (RFC 8628 §3.2).
+// Show UserCode and VerificationURI to the user, then call PollDeviceFlow.
+type DeviceCode struct {
+	Code                    string    `json:"device_code"`
+	UserCode                string    `json:"user_code"`
+	VerificationURI         string    `json:"verification_uri"`
+	VerificationURIComplete string    `json:"verification_uri_complete,omitempty"`
+	ExpiresIn               int       `json:"expires_in"`
+	Interval                int       `json:"interval,omitempty"`
+	ExpiresAt               time.Time `json:"-"`
+}
+
+// StartDeviceFlow requests a device code and user code from the device
+// authorization endpoint.
+func (e *Exchanger) StartDeviceFlow(ctx context.Context, req DeviceFlowRequest) (*DeviceCode, error) {
+	if req.DeviceAuthorizationEndpoint == "" {
+		return nil, fmt.Errorf("device authorization endpoint is required")
+	}
+	if req.ClientID == "" {
+		return nil, fmt.Errorf("client ID is required")
+	}
+
+	data := url.Values{}
+	data.Set("client_id", req.ClientID)
+	if req.ClientSecret != "" {
+		data.Set("client_secret", req.ClientSecret)
+	}
+	if req.Scope != "" {
+		data.Set("scope", req.Scope)
+	}
+
+	status, body, err := e.postForm(ctx, "device authorization", req.DeviceAuthorizationEndpoint, data)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, tokenResponseError(status, body)
+	}
+
+	var dc DeviceCode
+	if err := json.Unmarshal(body, &dc); err != nil {
+		return nil, fmt.Errorf("parsing device authorization response: %w", err)
+	}
+	if dc.Code == "" || dc.UserCode == "" || dc.VerificationURI == "" {
+		return nil, fmt.Errorf("device authorization response is missing required fields")
+	}
+	if dc.Interval <= 0 {
+		dc.Interval = defaultDeviceInterval
+	}
+	if dc.ExpiresIn > 0 {
+		dc.ExpiresAt = time.Now().Add(time.Duration(dc.ExpiresIn) * time.Second)
+	}
+
+	return &dc, nil
+}
+
+// PollDeviceFlow polls the token endpoint every dc.Interval seconds until the
+// user authorizes the device, denies it, or the device code expires.
+// authorization_pending keeps polling and slow_down lengthens the interval by
+// five seconds, per RFC 8628 §3.5. Cancel ctx to stop polling early.
+func (e *Exchanger) PollDeviceFlow(ctx context.Context, req DeviceFlowRequest, dc *DeviceCode) (*Token, error) {
+	if req.TokenEndpoint == "" {
+		return nil, fmt.Errorf("token endpoint is required")
+	}
+	if req.ClientID == "" {
+		return nil, fmt.Errorf("client ID is required")
+	}
+	if dc == nil || dc.Code == "" {
+		return nil, fmt.Errorf("device code is required")
+	}
+
+	data := url.Values{}
+	data.Set("grant_type", "urn:ietf:params:oauth:grant-type:device_code")
+	data.Set("device_code", dc.Code)
+	data.Set("client_id", req.ClientID)
+	if req.ClientSecret != "" {
+		data.Set("client_secret", req.ClientSecret)
+	}
+
+	unit := e.pollUnit
+	if unit <= 0 {
+		unit = time.Second
+	}
+	interval := dc.Interval
+	if interval <= 0 {
+		interval = defaultDeviceInterval
+	}
+
+	// Bound polling by the code's lifetime, measured in poll units.
+	if dc.ExpiresIn > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeoutCause(ctx, time.Duration(dc.ExpiresIn)*unit, ErrDeviceCodeExpired)
+		defer cancel()
+	}
+
+	for {
+		timer := time.NewTimer(time.Duration(interval) * unit)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, context.Cause(ctx)
+		case <-timer.C:
+		}
+
+		status, body, err := e.postForm(ctx, "token", req.TokenEndpoint, data)
+		if err != nil {
+			if cause := context.Cause(ctx); cause != nil {
+				return nil, cause
+			}
+			return nil, err
+		}
+		if status == http.StatusOK {
+			return parseToken(body)
+		}
+
+		var errResp oauthErrorResponse
+		_ = json.Unmarshal(body, &errResp)
+		switch errResp.Error {
+		case "authorization_pending":
+			continue
+		case "slow_down":
+			interval += slowDownIncrement
+			continue
+		case "expired_token":
+			return nil, ErrDeviceCodeExpired
+		case "access_denied":
+			return nil, ErrAccessDenied
+		default:
+			return nil, tokenResponseError(status, body)
+		}
+	}
+}
@@ -0,0 +1,177 @@
+package oauth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newTestDeviceExchanger(server *httptest.Server) *Exchanger {
+	e := NewExchanger(server.Client())
+	e.pollUnit = time.Millisecond
+	return e
+}
+
+func TestExchanger_StartDeviceFlow(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/device" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if err := r.ParseForm(); err != nil {
+			t.Fatalf("parsing form: %v", err)
+		}
+		if got := r.FormValue("client_id"); got != "client-123" {
+			t.Errorf("client_id = %q, want client-123", got)
+		}
+		if got := r.FormValue("scope"); got != "read" {
+			t.Errorf("scope = %q, want read", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"device_code":"dev-abc","user_code":"WDJB-MJHT","verification_uri":"https://example.com/device","expires_in":900}`))
+	}))
+	defer server.Close()
+
+	dc, err := NewExchanger(server.Client()).StartDeviceFlow(context.Background(), DeviceFlowRequest{
+		DeviceAuthorizationEndpoint: server.URL + "/device",
+		ClientID:                    "client-123",
+		Scope:                       "read",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if dc.Code != "dev-abc" || dc.UserCode != "WDJB-MJHT" {
+		t.Errorf("unexpected device code: %+v", dc)
+	}
+	if dc.Interval != defaultDeviceInterval {
+		t.Errorf("Interval = %d, want default %d", dc.Interval, defaultDeviceInterval)
+	}
+	if dc.ExpiresAt.IsZero() {
+		t.Error("ExpiresAt should be set from expires_in")
+	}
+}
+
+func TestExchanger_StartDeviceFlow_RequiresEndpointAndClientID(t *testing.T) {
+	e := NewExchanger(nil)
+	if _, err := e.StartDeviceFlow(context.Background(), DeviceFlowRequest{ClientID: "c"}); err == nil {
+		t.Error("expected error for missing device authorization endpoint")
+	}
+	if _, err := e.StartDeviceFlow(context.Background(), DeviceFlowRequest{DeviceAuthorizationEndpoint: "https://example.com/device"}); err == nil {
+		t.Error("expected error for missing client ID")
+	}
+}
+
+func TestExchanger_PollDeviceFlow(t *testing.T) {
+	responses := []string{
+		`{"error":"authorization_pending"}`,
+		`{"error":"slow_down"}`,
+		`{"error":"authorization_pending"}`,
+	}
+	var calls atomic.Int32
+	var times []time.Time
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := int(calls.Add(1)) - 1
+		times = append(times, time.Now())
+		if err := r.ParseForm(); err != nil {
+			t.Fatalf("parsing form: %v", err)
+		}
+		if got := r.FormValue("grant_type"); got != "urn:ietf:params:oauth:grant-type:device_code" {
+			t.Errorf("grant_type = %q", got)
+		}
+		if got := r.FormValue("device_code"); got != "dev-abc" {
+			t.Errorf("device_code = %q, want dev-abc", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if n < len(responses) {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(responses[n]))
+			return
+		}
+		_, _ = w.Write([]byte(`{"access_token":"access-xyz","token_type":"Bearer","expires_in":3600}`))
+	}))
+	defer server.Close()
+
+	token, err := newTestDeviceExchanger(server).PollDeviceFlow(context.Background(),
+		DeviceFlowRequest{TokenEndpoint: server.URL + "/token", ClientID: "client-123"},
+		&DeviceCode{Code: "dev-abc", Interval: 1, ExpiresIn: 10_000},
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if token.AccessToken != "access-xyz" {
+		t.Errorf("AccessToken = %q, want access-xyz", token.AccessToken)
+	}
+	if got := calls.Load(); got != 4 {
+		t.Errorf("expected 4 token requests, got %d", got)
+	}
+	// After slow_down the interval grows from 1 to 6 units.
+	if gap := times[2].Sub(times[1]); gap < 6*time.Millisecond {
+		t.Errorf("expected slow_down to lengthen the interval, gap was %v", gap)
+	}
+}
+
+func TestExchanger_PollDeviceFlow_TerminalErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want error
+	}{
+		{"expired", `{"error":"expired_token"}`, ErrDeviceCodeExpired},
+		{"denied", `{"error":"access_denied"}`, ErrAccessDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			_, err := newTestDeviceExchanger(server).PollDeviceFlow(context.Background(),
+				DeviceFlowRequest{TokenEndpoint: server.URL + "/token", ClientID: "client-123"},
+				&DeviceCode{Code: "dev-abc", Interval: 1},
+			)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestExchanger_PollDeviceFlow_ExpiresWhilePending(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"error":"authorization_pending"}`))
+	}))
+	defer server.Close()
+
+	_, err := newTestDeviceExchanger(server).PollDeviceFlow(context.Background(),
+		DeviceFlowRequest{TokenEndpoint: server.URL + "/token", ClientID: "client-123"},
+		&DeviceCode{Code: "dev-abc", Interval: 1, ExpiresIn: 20},
+	)
+	if !errors.Is(err, ErrDeviceCodeExpired) {
+		t.Errorf("expected ErrDeviceCodeExpired, got %v", err)
+	}
+}
+
+func TestExchanger_PollDeviceFlow_ContextCanceled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("no request expected after cancellation")
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := newTestDeviceExchanger(server).PollDeviceFlow(ctx,
+		DeviceFlowRequest{TokenEndpoint: server.URL + "/token", ClientID: "client-123"},
+		&DeviceCode{Code: "dev-abc", Interval: 1},
+	)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+}
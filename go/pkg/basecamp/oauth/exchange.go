@@ -3,6 +3,7 @@ package oauth
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -16,8 +17,22 @@ import (
 // Exchanger handles OAuth 2.0 token exchange and refresh operations.
 type Exchanger struct {
 	httpClient *http.Client
+
+	// pollUnit is the duration of one device-flow interval "second".
+	// Zero means time.Second; tests shorten it.
+	pollUnit time.Duration
+
+	// refreshDelay is the base delay between Refresh attempts.
+	// Zero means refreshRetryDelay; tests shorten it.
+	refreshDelay time.Duration
 }
 
+// Refresh retry policy for transient network errors.
+const (
+	refreshAttempts   = 3
+	refreshRetryDelay = 2 * time.Second
+)
+
 // NewExchanger creates an Exchanger with the given HTTP client.
 // If httpClient is nil, http.DefaultClient is used.
 func NewExchanger(httpClient *http.Client) *Exchanger {
@@ -64,6 +79,12 @@ func (e *Exchanger) Exchange(ctx context.Context, req ExchangeRequest) (*Token,
 }
 
 // Refresh exchanges a refresh token for a new access token.
+//
+// A request that fails before the token endpoint responds, such as a
+// connection error, is retried up to three attempts in total, waiting 2s and
+// then 4s between them. Error responses from the endpoint (for example
+// invalid_grant for a revoked refresh token) are returned without retrying.
+// Cancel ctx to stop waiting between attempts.
 func (e *Exchanger) Refresh(ctx context.Context, req RefreshRequest) (*Token, error) {
 	if req.TokenEndpoint == "" {
 		return nil, fmt.Errorf("token endpoint is required")
@@ -88,7 +109,25 @@ func (e *Exchanger) Refresh(ctx context.Context, req RefreshRequest) (*Token, er
 		data.Set("client_secret", req.ClientSecret)
 	}
 
-	return e.doTokenRequest(ctx, req.TokenEndpoint, data)
+	delay := e.refreshDelay
+	if delay <= 0 {
+		delay = refreshRetryDelay
+	}
+	for attempt := 1; ; attempt++ {
+		token, err := e.doTokenRequest(ctx, req.TokenEndpoint, data)
+		var te *transportError
+		if err == nil || attempt == refreshAttempts || !errors.As(err, &te) || ctx.Err() != nil {
+			return token, err
+		}
+
+		timer := time.NewTimer(delay << (attempt - 1))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, err
+		case <-timer.C:
+		}
+	}
 }
 
 // maxTokenResponseBytes is the maximum size for token endpoint response bodies (1 MB).
@@ -98,22 +137,35 @@ const maxTokenResponseBytes int64 = 1 * 1024 * 1024
 const maxErrorMessageLen = 500
 
 func (e *Exchanger) doTokenRequest(ctx context.Context, tokenEndpoint string, data url.Values) (*Token, error) {
+	status, body, err := e.postForm(ctx, "token", tokenEndpoint, data)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, tokenResponseError(status, body)
+	}
+	return parseToken(body)
+}
+
+// postForm POSTs form-encoded data to an OAuth endpoint and returns the status
+// code and bounded response body. label names the endpoint in error messages.
+func (e *Exchanger) postForm(ctx context.Context, label, endpoint string, data url.Values) (int, []byte, error) {
 	// Validate HTTPS to prevent sending tokens/credentials over plaintext
 	// Allow localhost for testing against local mock OAuth servers
-	if err := basecamp.RequireSecureEndpoint(tokenEndpoint); err != nil {
-		return nil, fmt.Errorf("token endpoint validation failed for %q: %w", tokenEndpoint, err)
+	if err := basecamp.RequireSecureEndpoint(endpoint); err != nil {
+		return 0, nil, fmt.Errorf("%s endpoint validation failed for %q: %w", label, endpoint, err)
 	}
 
-	httpReq, err := http.NewRequestWithContext(ctx, "POST", tokenEndpoint, strings.NewReader(data.Encode()))
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", endpoint, strings.NewReader(data.Encode()))
 	if err != nil {
-		return nil, fmt.Errorf("creating token request: %w", err)
+		return 0, nil, fmt.Errorf("creating %s request: %w", label, err)
 	}
 	httpReq.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 	httpReq.Header.Set("Accept", "application/json")
 
 	resp, err := e.httpClient.Do(httpReq) // #nosec G704 -- SDK HTTP client: URL is caller-configured
 	if err != nil {
-		return nil, fmt.Errorf("token request failed: %w", err)
+		return 0, nil, &transportError{err: fmt.Errorf("%s request failed: %w", label, err)}
 	}
 	defer func() { _ = resp.Body.Close() }()
 
@@ -121,35 +173,52 @@ func (e *Exchanger) doTokenRequest(ctx context.Context, tokenEndpoint string, da
 	lr := io.LimitReader(resp.Body, maxTokenResponseBytes+1)
 	body, err := io.ReadAll(lr)
 	if err != nil {
-		return nil, fmt.Errorf("reading token response: %w", err)
+		return 0, nil, fmt.Errorf("reading %s response: %w", label, err)
 	}
 	if int64(len(body)) > maxTokenResponseBytes {
-		return nil, fmt.Errorf("token response body exceeds %d byte limit", maxTokenResponseBytes)
+		return 0, nil, fmt.Errorf("%s response body exceeds %d byte limit", label, maxTokenResponseBytes)
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		// Try to parse error response
-		var errResp struct {
-			Error            string `json:"error"`
-			ErrorDescription string `json:"error_description"`
-		}
-		if json.Unmarshal(body, &errResp) == nil && errResp.Error != "" {
-			desc := errResp.ErrorDescription
-			if len(desc) > maxErrorMessageLen {
-				desc = desc[:maxErrorMessageLen-3] + "..."
-			}
-			if desc != "" {
-				return nil, fmt.Errorf("token error: %s - %s", errResp.Error, desc)
-			}
-			return nil, fmt.Errorf("token error: %s", errResp.Error)
+	return resp.StatusCode, body, nil
+}
+
+// transportError marks a request that got no response from the endpoint.
+type transportError struct {
+	err error
+}
+
+func (e *transportError) Error() string { return e.err.Error() }
+func (e *transportError) Unwrap() error { return e.err }
+
+// oauthErrorResponse is the RFC 6749 §5.2 error response body.
+type oauthErrorResponse struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// tokenResponseError builds an error from a non-200 token endpoint response.
+func tokenResponseError(status int, body []byte) error {
+	// Try to parse error response
+	var errResp oauthErrorResponse
+	if json.Unmarshal(body, &errResp) == nil && errResp.Error != "" {
+		desc := errResp.ErrorDescription
+		if len(desc) > maxErrorMessageLen {
+			desc = desc[:maxErrorMessageLen-3] + "..."
 		}
-		bodyStr := string(body)
-		if len(bodyStr) > maxErrorMessageLen {
-			bodyStr = bodyStr[:maxErrorMessageLen-3] + "..."
+		if desc != "" {
+			return fmt.Errorf("token error: %s - %s", errResp.Error, desc)
 		}
-		return nil, fmt.Errorf("token request failed with status %d: %s", resp.StatusCode, bodyStr)
+		return fmt.Errorf("token error: %s", errResp.Error)
+	}
+	bodyStr := string(body)
+	if len(bodyStr) > maxErrorMessageLen {
+		bodyStr = bodyStr[:maxErrorMessageLen-3] + "..."
 	}
+	return fmt.Errorf("token request failed with status %d: %s", status, bodyStr)
+}
 
+// parseToken decodes a successful token response.
+func parseToken(body []byte) (*Token, error) {
 	var token Token
 	if err := json.Unmarshal(body, &token); err != nil {
 		return nil, fmt.Errorf("parsing token response: %w", err)
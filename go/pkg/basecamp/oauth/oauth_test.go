@@ -366,6 +366,96 @@ func TestExchanger_Refresh(t *testing.T) {
 	}
 }
 
+func TestExchanger_Refresh_InvalidRefreshToken(t *testing.T) {
+	var requests int
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusUnauthorized)
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"error":             "invalid_grant",
+			"error_description": "refresh token revoked",
+		})
+	}))
+	defer server.Close()
+
+	e := NewExchanger(server.Client())
+	e.refreshDelay = time.Millisecond
+	_, err := e.Refresh(context.Background(), RefreshRequest{TokenEndpoint: server.URL, RefreshToken: "revoked"})
+	if err == nil || !strings.Contains(err.Error(), "invalid_grant") {
+		t.Fatalf("Refresh() error = %v, want invalid_grant", err)
+	}
+	if requests != 1 {
+		t.Errorf("expected 1 request for an error response, got %d", requests)
+	}
+}
+
+func TestExchanger_Refresh_RetriesNetworkErrors(t *testing.T) {
+	var requests int
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests < refreshAttempts {
+			// Drop the connection without a response.
+			conn, _, err := w.(http.Hijacker).Hijack()
+			if err == nil {
+				_ = conn.Close()
+			}
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"access_token": "new_access123"})
+	}))
+	defer server.Close()
+
+	e := NewExchanger(server.Client())
+	e.refreshDelay = time.Millisecond
+	token, err := e.Refresh(context.Background(), RefreshRequest{TokenEndpoint: server.URL, RefreshToken: "refresh123"})
+	if err != nil {
+		t.Fatalf("Refresh() error = %v", err)
+	}
+	if token.AccessToken != "new_access123" {
+		t.Errorf("AccessToken = %q, want new_access123", token.AccessToken)
+	}
+	if requests != refreshAttempts {
+		t.Errorf("expected %d requests, got %d", refreshAttempts, requests)
+	}
+}
+
+func TestExchanger_Refresh_NetworkErrorGivesUp(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	endpoint := server.URL
+	client := server.Client()
+	server.Close()
+
+	e := NewExchanger(client)
+	e.refreshDelay = time.Millisecond
+	start := time.Now()
+	_, err := e.Refresh(context.Background(), RefreshRequest{TokenEndpoint: endpoint, RefreshToken: "refresh123"})
+	if err == nil || !strings.Contains(err.Error(), "token request failed") {
+		t.Fatalf("Refresh() error = %v, want network error", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Refresh() took %v with a 1ms retry delay", elapsed)
+	}
+}
+
+func TestExchanger_Refresh_ContextCanceledBetweenAttempts(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	endpoint := server.URL
+	client := server.Client()
+	server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	e := NewExchanger(client)
+	start := time.Now()
+	if _, err := e.Refresh(ctx, RefreshRequest{TokenEndpoint: endpoint, RefreshToken: "refresh123"}); err == nil {
+		t.Fatal("Refresh() expected error")
+	}
+	if elapsed := time.Since(start); elapsed >= refreshRetryDelay {
+		t.Errorf("Refresh() waited %v after the context was done", elapsed)
+	}
+}
+
 func TestToken_ExpiresAt(t *testing.T) {
 	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		_ = json.NewEncoder(w).Encode(map[string]any{
@@ -494,3 +584,135 @@ func TestExchanger_Exchange_TruncatesLargeErrorDescription(t *testing.T) {
 		t.Error("Expected '...' suffix in truncated error description")
 	}
 }
+
+func TestToken_Expiry(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name         string
+		expiresAt    time.Time
+		wantExpired  bool
+		wantSoon5Min bool
+	}{
+		{"zero never expires", time.Time{}, false, false},
+		{"expired", now.Add(-time.Minute), true, true},
+		{"expires in 3 minutes", now.Add(3 * time.Minute), false, true},
+		{"expires in 7 minutes", now.Add(7 * time.Minute), false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token := &Token{AccessToken: "x", ExpiresAt: tt.expiresAt}
+			if got := token.IsExpired(); got != tt.wantExpired {
+				t.Errorf("IsExpired() = %v, want %v", got, tt.wantExpired)
+			}
+			if got := token.WillExpireSoon(5 * time.Minute); got != tt.wantSoon5Min {
+				t.Errorf("WillExpireSoon(5m) = %v, want %v", got, tt.wantSoon5Min)
+			}
+		})
+	}
+}
+
+func TestToken_TimeUntilExpiry(t *testing.T) {
+	token := &Token{ExpiresAt: time.Now().Add(time.Hour)}
+	if got := token.TimeUntilExpiry(); got <= 59*time.Minute || got > time.Hour {
+		t.Errorf("TimeUntilExpiry() = %v, want about 1h", got)
+	}
+
+	expired := &Token{ExpiresAt: time.Now().Add(-time.Hour)}
+	if got := expired.TimeUntilExpiry(); got >= 0 {
+		t.Errorf("TimeUntilExpiry() = %v for expired token, want negative", got)
+	}
+
+	never := &Token{}
+	if got := never.TimeUntilExpiry(); got < 100*365*24*time.Hour {
+		t.Errorf("TimeUntilExpiry() = %v for zero ExpiresAt, want effectively unbounded", got)
+	}
+}
+
+func TestClientConfig_Validate(t *testing.T) {
+	valid := ClientConfig{
+		ClientID:              "client-123",
+		RedirectURI:           "https://app.example.com/callback",
+		TokenEndpoint:         "https://launchpad.37signals.com/authorization/token",
+		AuthorizationEndpoint: "https://launchpad.37signals.com/authorization/new",
+	}
+	if err := valid.Validate(); err != nil {
+		t.Errorf("Validate() on valid config = %v", err)
+	}
+
+	local := valid
+	local.TokenEndpoint = "http://localhost:3000/token"
+	if err := local.Validate(); err != nil {
+		t.Errorf("Validate() with localhost token endpoint = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		mutate  func(*ClientConfig)
+		wantMsg string
+	}{
+		{"empty client ID", func(c *ClientConfig) { c.ClientID = "" }, "client ID is required"},
+		{"empty token endpoint", func(c *ClientConfig) { c.TokenEndpoint = "" }, "token endpoint is required"},
+		{"non-HTTPS token endpoint", func(c *ClientConfig) { c.TokenEndpoint = "http://launchpad.37signals.com/authorization/token" }, "token endpoint must use HTTPS"},
+		{"non-HTTPS authorization endpoint", func(c *ClientConfig) { c.AuthorizationEndpoint = "http://example.com/authorize" }, "authorization endpoint must use HTTPS"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := valid
+			tt.mutate(&cfg)
+			err := cfg.Validate()
+			if err == nil {
+				t.Fatal("expected error")
+			}
+			if !strings.Contains(err.Error(), tt.wantMsg) {
+				t.Errorf("error = %q, want it to contain %q", err, tt.wantMsg)
+			}
+			var apiErr *basecamp.Error
+			if !errors.As(err, &apiErr) || apiErr.Code != basecamp.CodeUsage {
+				t.Errorf("expected usage error, got %v", err)
+			}
+		})
+	}
+
+	// Every problem is reported.
+	err := ClientConfig{TokenEndpoint: "http://example.com/token"}.Validate()
+	if err == nil || !strings.Contains(err.Error(), "client ID is required") || !strings.Contains(err.Error(), "token endpoint must use HTTPS") {
+		t.Errorf("Validate() = %v, want both problems reported", err)
+	}
+}
+
+func TestClientConfig_Requests(t *testing.T) {
+	cfg := ClientConfig{
+		ClientID:        "client-123",
+		ClientSecret:    "secret",
+		RedirectURI:     "https://app.example.com/callback",
+		TokenEndpoint:   "https://launchpad.37signals.com/authorization/token",
+		UseLegacyFormat: true,
+	}
+
+	ex := cfg.ExchangeRequest("code-abc", "verifier")
+	want := ExchangeRequest{
+		TokenEndpoint:   cfg.TokenEndpoint,
+		Code:            "code-abc",
+		RedirectURI:     cfg.RedirectURI,
+		ClientID:        "client-123",
+		ClientSecret:    "secret",
+		CodeVerifier:    "verifier",
+		UseLegacyFormat: true,
+	}
+	if ex != want {
+		t.Errorf("ExchangeRequest() = %+v, want %+v", ex, want)
+	}
+
+	rf := cfg.RefreshRequest("refresh-xyz")
+	wantRefresh := RefreshRequest{
+		TokenEndpoint:   cfg.TokenEndpoint,
+		RefreshToken:    "refresh-xyz",
+		ClientID:        "client-123",
+		ClientSecret:    "secret",
+		UseLegacyFormat: true,
+	}
+	if rf != wantRefresh {
+		t.Errorf("RefreshRequest() = %+v, want %+v", rf, wantRefresh)
+	}
+}
@@ -4,6 +4,17 @@ import (
 	"crypto/rand"
 	"crypto/sha256"
 	"encoding/base64"
+	"fmt"
+
+	"github.com/basecamp/basecamp-sdk/go/pkg/basecamp"
+)
+
+// Code verifier length bounds from RFC 7636 section 4.1.
+const (
+	MinVerifierLength = 43
+	MaxVerifierLength = 128
+
+	defaultVerifierLength = 96
 )
 
 // PKCE holds a code verifier and its corresponding challenge for OAuth 2.0 PKCE flow.
@@ -15,7 +26,7 @@ type PKCE struct {
 }
 
 // GeneratePKCE returns a cryptographically secure PKCE code verifier and its SHA256 code challenge.
-// The verifier is 43 characters (32 random bytes, base64url-encoded without padding).
+// The verifier is 96 characters of base64url alphabet; see GeneratePKCEWithLength.
 // The challenge is the base64url-encoded SHA256 hash of the verifier.
 //
 // Use the Challenge with code_challenge_method=S256 in the authorization request,
@@ -31,12 +42,25 @@ type PKCE struct {
 //	// Later, during token exchange:
 //	token, err := oauth.Exchange(ctx, code, pkce.Verifier, ...)
 func GeneratePKCE() (*PKCE, error) {
-	b := make([]byte, 32)
+	return GeneratePKCEWithLength(defaultVerifierLength)
+}
+
+// GeneratePKCEWithLength is like GeneratePKCE but returns a verifier of
+// exactly n characters from the base64url alphabet. RFC 7636 allows 43 to 128
+// characters; other lengths return a usage error.
+func GeneratePKCEWithLength(n int) (*PKCE, error) {
+	if n < MinVerifierLength || n > MaxVerifierLength {
+		return nil, basecamp.ErrUsage(fmt.Sprintf("PKCE verifier length must be between %d and %d, got %d",
+			MinVerifierLength, MaxVerifierLength, n))
+	}
+
+	// Each base64 character carries 6 bits, so ceil(n*6/8) bytes suffice.
+	b := make([]byte, (n*6+7)/8)
 	if _, err := rand.Read(b); err != nil {
 		return nil, err
 	}
 
-	verifier := base64.RawURLEncoding.EncodeToString(b)
+	verifier := base64.RawURLEncoding.EncodeToString(b)[:n]
 	h := sha256.Sum256([]byte(verifier))
 	challenge := base64.RawURLEncoding.EncodeToString(h[:])
 
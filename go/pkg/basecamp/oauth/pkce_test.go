@@ -0,0 +1,61 @@
+package oauth
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"regexp"
+	"testing"
+
+	"github.com/basecamp/basecamp-sdk/go/pkg/basecamp"
+)
+
+var verifierAlphabet = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+func TestGeneratePKCEWithLength(t *testing.T) {
+	for _, n := range []int{43, 44, 64, 96, 127, 128} {
+		pkce, err := GeneratePKCEWithLength(n)
+		if err != nil {
+			t.Fatalf("GeneratePKCEWithLength(%d): %v", n, err)
+		}
+		if len(pkce.Verifier) != n {
+			t.Errorf("GeneratePKCEWithLength(%d): verifier length = %d", n, len(pkce.Verifier))
+		}
+		if !verifierAlphabet.MatchString(pkce.Verifier) {
+			t.Errorf("GeneratePKCEWithLength(%d): verifier %q has non-base64url characters", n, pkce.Verifier)
+		}
+		h := sha256.Sum256([]byte(pkce.Verifier))
+		if want := base64.RawURLEncoding.EncodeToString(h[:]); pkce.Challenge != want {
+			t.Errorf("GeneratePKCEWithLength(%d): challenge = %q, want %q", n, pkce.Challenge, want)
+		}
+	}
+}
+
+func TestGeneratePKCEWithLength_OutOfRange(t *testing.T) {
+	for _, n := range []int{0, 42, 129} {
+		pkce, err := GeneratePKCEWithLength(n)
+		if err == nil {
+			t.Errorf("GeneratePKCEWithLength(%d): expected error, got %+v", n, pkce)
+			continue
+		}
+		if code := basecamp.ErrorCode(err); code != basecamp.CodeUsage {
+			t.Errorf("GeneratePKCEWithLength(%d): error code = %q, want %q", n, code, basecamp.CodeUsage)
+		}
+	}
+}
+
+func TestGeneratePKCE_DefaultLength(t *testing.T) {
+	a, err := GeneratePKCE()
+	if err != nil {
+		t.Fatalf("GeneratePKCE: %v", err)
+	}
+	if len(a.Verifier) != 96 {
+		t.Errorf("verifier length = %d, want 96", len(a.Verifier))
+	}
+	b, err := GeneratePKCE()
+	if err != nil {
+		t.Fatalf("GeneratePKCE: %v", err)
+	}
+	if a.Verifier == b.Verifier {
+		t.Error("expected distinct verifiers across calls")
+	}
+}
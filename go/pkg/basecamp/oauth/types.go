@@ -3,6 +3,7 @@ package oauth
 
 import (
 	"errors"
+	"math"
 	"time"
 
 	"github.com/basecamp/basecamp-sdk/go/pkg/basecamp"
@@ -195,6 +196,92 @@ type Token struct {
 	Scope        string    `json:"scope,omitempty"`
 }
 
+// IsExpired reports whether the token's expiry time has passed.
+// A token with a zero ExpiresAt never expires.
+func (t *Token) IsExpired() bool {
+	return !t.ExpiresAt.IsZero() && t.ExpiresAt.Before(time.Now())
+}
+
+// WillExpireSoon reports whether the token expires within window, e.g. to
+// refresh it ahead of time. A token with a zero ExpiresAt never expires.
+func (t *Token) WillExpireSoon(window time.Duration) bool {
+	return t.TimeUntilExpiry() < window
+}
+
+// TimeUntilExpiry returns the time left before the token expires, which is
+// negative once it has expired. A token with a zero ExpiresAt never expires,
+// so the maximum time.Duration is returned.
+func (t *Token) TimeUntilExpiry() time.Duration {
+	if t.ExpiresAt.IsZero() {
+		return time.Duration(math.MaxInt64)
+	}
+	return time.Until(t.ExpiresAt)
+}
+
+// ClientConfig holds an OAuth client's registration and the authorization
+// server endpoints it uses, so they can be set once and turned into
+// ExchangeRequest and RefreshRequest values. It is distinct from Config,
+// which describes the authorization server's own metadata.
+type ClientConfig struct {
+	ClientID              string
+	ClientSecret          string
+	RedirectURI           string
+	TokenEndpoint         string
+	AuthorizationEndpoint string
+
+	// UseLegacyFormat uses Launchpad's non-standard token format
+	// (see ExchangeRequest and RefreshRequest).
+	UseLegacyFormat bool
+}
+
+// Validate reports problems that would make token requests fail: a missing
+// client ID, or a token or authorization endpoint that is missing or not
+// HTTPS (http is allowed on localhost). The returned error joins every
+// problem found (see errors.Join).
+func (c ClientConfig) Validate() error {
+	var errs []error
+	if c.ClientID == "" {
+		errs = append(errs, basecamp.ErrUsage("client ID is required"))
+	}
+	if c.TokenEndpoint == "" {
+		errs = append(errs, basecamp.ErrUsage("token endpoint is required"))
+	} else if err := basecamp.RequireSecureEndpoint(c.TokenEndpoint); err != nil {
+		errs = append(errs, basecamp.ErrUsage("token endpoint must use HTTPS: "+c.TokenEndpoint))
+	}
+	if c.AuthorizationEndpoint != "" {
+		if err := basecamp.RequireSecureEndpoint(c.AuthorizationEndpoint); err != nil {
+			errs = append(errs, basecamp.ErrUsage("authorization endpoint must use HTTPS: "+c.AuthorizationEndpoint))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ExchangeRequest returns an ExchangeRequest for code using this client's
+// settings. codeVerifier may be empty when PKCE is not used.
+func (c ClientConfig) ExchangeRequest(code, codeVerifier string) ExchangeRequest {
+	return ExchangeRequest{
+		TokenEndpoint:   c.TokenEndpoint,
+		Code:            code,
+		RedirectURI:     c.RedirectURI,
+		ClientID:        c.ClientID,
+		ClientSecret:    c.ClientSecret,
+		CodeVerifier:    codeVerifier,
+		UseLegacyFormat: c.UseLegacyFormat,
+	}
+}
+
+// RefreshRequest returns a RefreshRequest for refreshToken using this
+// client's settings.
+func (c ClientConfig) RefreshRequest(refreshToken string) RefreshRequest {
+	return RefreshRequest{
+		TokenEndpoint:   c.TokenEndpoint,
+		RefreshToken:    refreshToken,
+		ClientID:        c.ClientID,
+		ClientSecret:    c.ClientSecret,
+		UseLegacyFormat: c.UseLegacyFormat,
+	}
+}
+
 // ExchangeRequest contains parameters for exchanging an authorization code for tokens.
 type ExchangeRequest struct {
 	TokenEndpoint string
@@ -50,6 +50,34 @@ type GatingHooks interface {
 	OnOperationGate(ctx context.Context, op OperationInfo) (context.Context, error)
 }
 
+// RequestGatingHooks extends Hooks with per-request gating. Where
+// OnOperationGate admits an operation once, OnRequestGate is consulted before
+// every HTTP request the client sends, including retries and each page a
+// list operation follows, so a breaker can stop an operation part-way.
+type RequestGatingHooks interface {
+	Hooks
+	// OnRequestGate is called before OnRequestStart. It returns the context
+	// to send the request with and an error. Return a non-nil error to
+	// reject the request: it is not sent, OnRequestStart and OnRequestEnd are
+	// not called, the request is not retried, and the operation fails with
+	// an error that wraps it (use errors.Is to check for it).
+	OnRequestGate(ctx context.Context, info RequestInfo) (context.Context, error)
+}
+
+// CircuitHooks extends Hooks with circuit breaker state notifications.
+// Hooks that implement it are told when a circuit breaker installed with
+// WithCircuitBreaker or WithResilience changes state. Breakers are scoped
+// per operation, so op identifies the circuit (e.g., "Todos.List").
+type CircuitHooks interface {
+	Hooks
+	// OnCircuitOpen is called when the circuit for op opens and starts
+	// rejecting operations with ErrCircuitOpen.
+	OnCircuitOpen(ctx context.Context, op OperationInfo)
+	// OnCircuitClose is called when the circuit for op closes again after
+	// enough successful trial operations.
+	OnCircuitClose(ctx context.Context, op OperationInfo)
+}
+
 // RequestInfo contains information about an HTTP request.
 type RequestInfo struct {
 	Method string
@@ -72,6 +100,9 @@ type OperationInfo struct {
 	IsMutation bool
 	// ResourceID is the specific resource ID if applicable.
 	ResourceID int64
+	// AccountID is the Basecamp account the operation runs against.
+	// Empty for account-agnostic operations such as Authorization.GetInfo.
+	AccountID string
 }
 
 // RequestResult contains the result of an HTTP request.
@@ -89,6 +120,10 @@ type RequestResult struct {
 	// RetryAfter is the Retry-After header value in seconds (0 if not present).
 	// Used by resilience hooks to respect server-requested backoff on 429/503.
 	RetryAfter int
+	// ResponseSize is the number of response body bytes read. When a response
+	// has a body, OnRequestEnd is called once the body is read to EOF or
+	// closed, so this counts what the SDK or caller actually consumed.
+	ResponseSize int64
 }
 
 // NoopHooks is a no-op implementation of Hooks.
@@ -96,8 +131,11 @@ type RequestResult struct {
 // resulting in zero overhead when no observability is needed.
 type NoopHooks struct{}
 
-// Ensure NoopHooks implements Hooks at compile time.
-var _ Hooks = NoopHooks{}
+// Ensure NoopHooks implements CircuitHooks and RequestGatingHooks at compile time.
+var (
+	_ CircuitHooks       = NoopHooks{}
+	_ RequestGatingHooks = NoopHooks{}
+)
 
 // OnOperationStart does nothing and returns the context unchanged.
 func (NoopHooks) OnOperationStart(ctx context.Context, _ OperationInfo) context.Context { return ctx }
@@ -114,6 +152,17 @@ func (NoopHooks) OnRequestEnd(context.Context, RequestInfo, RequestResult) {}
 // OnRetry does nothing.
 func (NoopHooks) OnRetry(context.Context, RequestInfo, int, error) {}
 
+// OnRequestGate admits every request and returns the context unchanged.
+func (NoopHooks) OnRequestGate(ctx context.Context, _ RequestInfo) (context.Context, error) {
+	return ctx, nil
+}
+
+// OnCircuitOpen does nothing.
+func (NoopHooks) OnCircuitOpen(context.Context, OperationInfo) {}
+
+// OnCircuitClose does nothing.
+func (NoopHooks) OnCircuitClose(context.Context, OperationInfo) {}
+
 // ChainHooks combines multiple Hooks implementations.
 // Start events are called in order, end events are called in reverse order.
 // This allows proper nesting of spans/traces.
@@ -179,6 +228,24 @@ func (c *ChainHooks) OnRetry(ctx context.Context, info RequestInfo, attempt int,
 	}
 }
 
+// OnCircuitOpen calls every CircuitHooks implementation in the chain, in order.
+func (c *ChainHooks) OnCircuitOpen(ctx context.Context, op OperationInfo) {
+	for _, h := range c.hooks {
+		if ch, ok := h.(CircuitHooks); ok {
+			ch.OnCircuitOpen(ctx, op)
+		}
+	}
+}
+
+// OnCircuitClose calls every CircuitHooks implementation in the chain, in order.
+func (c *ChainHooks) OnCircuitClose(ctx context.Context, op OperationInfo) {
+	for _, h := range c.hooks {
+		if ch, ok := h.(CircuitHooks); ok {
+			ch.OnCircuitClose(ctx, op)
+		}
+	}
+}
+
 // OnOperationGate calls the first GatingHooks implementation in the chain.
 // Only ONE gater should exist in a chain (typically resilienceHooks which
 // internally manages circuit breaker, bulkhead, and rate limiter).
@@ -193,6 +260,21 @@ func (c *ChainHooks) OnOperationGate(ctx context.Context, op OperationInfo) (con
 	return ctx, nil
 }
 
+// OnRequestGate calls every RequestGatingHooks implementation in the chain,
+// in order, passing each the context returned by the previous one. It stops
+// at the first error.
+func (c *ChainHooks) OnRequestGate(ctx context.Context, info RequestInfo) (context.Context, error) {
+	for _, h := range c.hooks {
+		if gater, ok := h.(RequestGatingHooks); ok {
+			var err error
+			if ctx, err = gater.OnRequestGate(ctx, info); err != nil {
+				return ctx, err
+			}
+		}
+	}
+	return ctx, nil
+}
+
 // WithHooks sets the observability hooks for the client.
 // Pass nil to disable hooks (uses NoopHooks).
 func WithHooks(hooks Hooks) ClientOption {
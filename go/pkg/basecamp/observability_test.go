@@ -2,6 +2,8 @@ package basecamp
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -450,3 +452,207 @@ func TestHookContextPropagation(t *testing.T) {
 			checkingTransport.capturedCtx.Value(key), expectedValue)
 	}
 }
+
+// circuitHooks records circuit state notifications.
+type circuitHooks struct {
+	NoopHooks
+	opened []OperationInfo
+	closed []OperationInfo
+}
+
+func (h *circuitHooks) OnCircuitOpen(_ context.Context, op OperationInfo) {
+	h.opened = append(h.opened, op)
+}
+
+func (h *circuitHooks) OnCircuitClose(_ context.Context, op OperationInfo) {
+	h.closed = append(h.closed, op)
+}
+
+func TestChainHooks_CircuitHooks(t *testing.T) {
+	ctx := context.Background()
+	op := OperationInfo{Service: "Todos", Operation: "List"}
+
+	first := &circuitHooks{}
+	second := &circuitHooks{}
+	plain := &recordingHooks{id: "plain"}
+	chain := NewChainHooks(first, plain, second).(*ChainHooks)
+
+	chain.OnCircuitOpen(ctx, op)
+	chain.OnCircuitClose(ctx, op)
+
+	for name, h := range map[string]*circuitHooks{"first": first, "second": second} {
+		if len(h.opened) != 1 || h.opened[0] != op {
+			t.Errorf("%s: opened = %v, want [%v]", name, h.opened, op)
+		}
+		if len(h.closed) != 1 || h.closed[0] != op {
+			t.Errorf("%s: closed = %v, want [%v]", name, h.closed, op)
+		}
+	}
+}
+
+// requestGate admits the first allow requests and rejects the rest with
+// errGateClosed, recording each operation's final error.
+type requestGate struct {
+	NoopHooks
+	allow    int
+	gated    int
+	started  int
+	opErrors []error
+}
+
+var errGateClosed = errors.New("request gate closed")
+
+func (h *requestGate) OnRequestGate(ctx context.Context, _ RequestInfo) (context.Context, error) {
+	h.gated++
+	if h.gated > h.allow {
+		return ctx, errGateClosed
+	}
+	return ctx, nil
+}
+
+func (h *requestGate) OnRequestStart(ctx context.Context, _ RequestInfo) context.Context {
+	h.started++
+	return ctx
+}
+
+func (h *requestGate) OnOperationEnd(_ context.Context, _ OperationInfo, err error, _ time.Duration) {
+	h.opErrors = append(h.opErrors, err)
+}
+
+func TestRequestGate_StopsPagination(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Link", fmt.Sprintf(`<http://%s/99999/projects.json?page=%d>; rel="next"`, r.Host, requests+1))
+		fmt.Fprintf(w, `[{"id":%d,"name":"Project"}]`, requests)
+	}))
+	defer server.Close()
+
+	gate := &requestGate{allow: 1}
+	cfg := DefaultConfig()
+	cfg.BaseURL = server.URL
+	client := NewClient(cfg, &StaticTokenProvider{Token: "test-token"}, WithHooks(gate))
+
+	_, err := client.ForAccount("99999").Projects().List(context.Background(), nil)
+	if !errors.Is(err, errGateClosed) {
+		t.Fatalf("List() error = %v, want errGateClosed", err)
+	}
+	if requests != 1 {
+		t.Errorf("server received %d requests, want 1", requests)
+	}
+	if gate.gated != 2 || gate.started != 1 {
+		t.Errorf("gate calls = %d, request starts = %d, want 2 and 1", gate.gated, gate.started)
+	}
+	if len(gate.opErrors) != 1 || !errors.Is(gate.opErrors[0], errGateClosed) {
+		t.Errorf("OnOperationEnd errors = %v, want [errGateClosed]", gate.opErrors)
+	}
+}
+
+func TestRequestGate_RejectedRequestIsNotRetried(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	}))
+	defer server.Close()
+
+	gate := &requestGate{allow: 0}
+	cfg := DefaultConfig()
+	cfg.BaseURL = server.URL
+	client := NewClient(cfg, &StaticTokenProvider{Token: "test-token"}, WithHooks(gate))
+
+	_, err := client.Get(context.Background(), "/projects.json")
+	if err != errGateClosed {
+		t.Fatalf("Get() error = %v, want errGateClosed itself", err)
+	}
+	if requests != 0 || gate.gated != 1 {
+		t.Errorf("requests = %d, gate calls = %d, want 0 and 1", requests, gate.gated)
+	}
+}
+
+func TestRequestGate_ServiceMethodIsNotRetried(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	}))
+	defer server.Close()
+
+	gate := &requestGate{allow: 0}
+	cfg := DefaultConfig()
+	cfg.BaseURL = server.URL
+	client := NewClient(cfg, &StaticTokenProvider{Token: "test-token"}, WithHooks(gate))
+
+	start := time.Now()
+	_, err := client.ForAccount("99999").People().List(context.Background(), nil)
+	if !errors.Is(err, errGateClosed) {
+		t.Fatalf("List() error = %v, want errGateClosed", err)
+	}
+	if requests != 0 || gate.gated != 1 {
+		t.Errorf("requests = %d, gate calls = %d, want 0 and 1", requests, gate.gated)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("List() took %v to fail, want no retry backoff", elapsed)
+	}
+}
+
+func TestChainHooks_OnRequestGate(t *testing.T) {
+	type ctxKey string
+	info := RequestInfo{Method: "GET", URL: "https://example.com", Attempt: 1}
+
+	adder := &contextGate{key: ctxKey("k"), value: "v"}
+	denier := &requestGate{allow: 0}
+	after := &requestGate{allow: 1}
+
+	chain := NewChainHooks(adder, &recordingHooks{}, after).(*ChainHooks)
+	ctx, err := chain.OnRequestGate(context.Background(), info)
+	if err != nil {
+		t.Fatalf("OnRequestGate() error = %v", err)
+	}
+	if ctx.Value(ctxKey("k")) != "v" {
+		t.Error("expected context from the first gate to be returned")
+	}
+
+	chain = NewChainHooks(denier, after).(*ChainHooks)
+	after.gated = 0
+	if _, err := chain.OnRequestGate(context.Background(), info); !errors.Is(err, errGateClosed) {
+		t.Fatalf("OnRequestGate() error = %v, want errGateClosed", err)
+	}
+	if after.gated != 0 {
+		t.Errorf("gate after a rejection was called %d times, want 0", after.gated)
+	}
+}
+
+// contextGate admits every request and adds a value to its context.
+type contextGate struct {
+	NoopHooks
+	key   any
+	value any
+}
+
+func (h *contextGate) OnRequestGate(ctx context.Context, _ RequestInfo) (context.Context, error) {
+	return context.WithValue(ctx, h.key, h.value), nil
+}
+
+func TestLoggingTransport_ResponseSize(t *testing.T) {
+	const body = `{"id":1,"name":"Launch"}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	hooks := &recordingHooks{}
+	cfg := DefaultConfig()
+	cfg.BaseURL = server.URL
+	client := NewClient(cfg, &StaticTokenProvider{Token: "test-token"}, WithHooks(hooks))
+
+	if _, err := client.ForAccount("12345").Get(context.Background(), "/projects/1.json"); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if len(hooks.endCalls) != 1 {
+		t.Fatalf("OnRequestEnd called %d times, want 1", len(hooks.endCalls))
+	}
+	if got := hooks.endCalls[0].ResponseSize; got != int64(len(body)) {
+		t.Errorf("ResponseSize = %d, want %d", got, len(body))
+	}
+}
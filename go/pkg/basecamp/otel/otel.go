@@ -36,6 +36,7 @@ const (
 	attrBasecampOperation    = "basecamp.operation"
 	attrBasecampResourceType = "basecamp.resource_type"
 	attrBasecampIsMutation   = "basecamp.is_mutation"
+	attrBasecampAccountID    = "basecamp.account_id"
 	attrBasecampMethod       = "basecamp.method"
 	attrBasecampURL          = "basecamp.url"
 	attrBasecampAttempt      = "basecamp.attempt"
@@ -152,6 +153,9 @@ func (h *Hooks) OnOperationStart(ctx context.Context, op basecamp.OperationInfo)
 	if op.ResourceID != 0 {
 		span.SetAttributes(attribute.Int64("basecamp.resource_id", op.ResourceID))
 	}
+	if op.AccountID != "" {
+		span.SetAttributes(attribute.String(attrBasecampAccountID, op.AccountID))
+	}
 
 	return context.WithValue(ctx, operationSpanKey{}, span)
 }
@@ -176,6 +180,7 @@ func (h *Hooks) OnOperationEnd(ctx context.Context, op basecamp.OperationInfo, e
 		h.operationDuration.Record(ctx, duration.Seconds(), metric.WithAttributes(
 			attribute.String(attrBasecampService, op.Service),
 			attribute.String(attrBasecampOperation, op.Operation),
+			attribute.String(attrBasecampAccountID, op.AccountID),
 		))
 	}
 }
@@ -56,6 +56,7 @@ func TestOnOperationStartEnd(t *testing.T) {
 		ResourceType: "todo",
 		IsMutation:   true,
 		ResourceID:   456,
+		AccountID:    "99999",
 	}
 
 	// Start operation
@@ -96,6 +97,9 @@ func TestOnOperationStartEnd(t *testing.T) {
 	if attrs["basecamp.resource_id"] != int64(456) {
 		t.Errorf("expected basecamp.resource_id=456, got %v", attrs["basecamp.resource_id"])
 	}
+	if attrs["basecamp.account_id"] != "99999" {
+		t.Errorf("expected basecamp.account_id='99999', got %v", attrs["basecamp.account_id"])
+	}
 }
 
 func TestOnOperationEndWithError(t *testing.T) {
@@ -13,6 +13,7 @@ import (
 	"sync"
 	"sync/atomic"
 	"testing"
+	"time"
 )
 
 // paginationHandler serves paginated responses for testing.
@@ -662,6 +663,103 @@ func TestParseNextLink(t *testing.T) {
 	}
 }
 
+func TestParseLinkHeader(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		want   map[string]string
+	}{
+		{
+			name:   "empty header",
+			header: "",
+			want:   map[string]string{},
+		},
+		{
+			name:   "multiple rels",
+			header: `<https://api.example.com/items?page=1>; rel="first", <https://api.example.com/items?page=2>; rel="prev", <https://api.example.com/items?page=4>; rel="next", <https://api.example.com/items?page=10>; rel="last"`,
+			want: map[string]string{
+				"first": "https://api.example.com/items?page=1",
+				"prev":  "https://api.example.com/items?page=2",
+				"next":  "https://api.example.com/items?page=4",
+				"last":  "https://api.example.com/items?page=10",
+			},
+		},
+		{
+			name:   "relative URLs returned unresolved",
+			header: `</12345/projects.json?page=2>; rel="next", </12345/projects.json?page=1>; rel="prev"`,
+			want: map[string]string{
+				"next": "/12345/projects.json?page=2",
+				"prev": "/12345/projects.json?page=1",
+			},
+		},
+		{
+			name:   "unquoted and space-separated rels",
+			header: `<https://api.example.com/items?page=2>; rel=next, <https://api.example.com/items?page=1>; title="start"; rel="first prev"`,
+			want: map[string]string{
+				"next":  "https://api.example.com/items?page=2",
+				"first": "https://api.example.com/items?page=1",
+				"prev":  "https://api.example.com/items?page=1",
+			},
+		},
+		{
+			name:   "comma inside URL",
+			header: `<https://api.example.com/items?ids=1,2&page=2>; rel="next"`,
+			want: map[string]string{
+				"next": "https://api.example.com/items?ids=1,2&page=2",
+			},
+		},
+		{
+			name:   "link without rel ignored",
+			header: `<https://api.example.com/other>, <https://api.example.com/items?page=2>; rel="next"`,
+			want: map[string]string{
+				"next": "https://api.example.com/items?page=2",
+			},
+		},
+		{
+			name:   "malformed header",
+			header: `https://api.example.com/items?page=2; rel="next"`,
+			want:   map[string]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ParseLinkHeader(tt.header)
+			if len(got) != len(tt.want) {
+				t.Fatalf("ParseLinkHeader(%q) = %v, want %v", tt.header, got, tt.want)
+			}
+			for rel, url := range tt.want {
+				if got[rel] != url {
+					t.Errorf("ParseLinkHeader(%q)[%q] = %q, want %q", tt.header, rel, got[rel], url)
+				}
+			}
+		})
+	}
+}
+
+func TestResponse_LinkAccessors(t *testing.T) {
+	resp := &Response{Headers: http.Header{}}
+	resp.Headers.Set("Link", `<https://api.example.com/items?page=1>; rel="first", <https://api.example.com/items?page=2>; rel="prev", <https://api.example.com/items?page=4>; rel="next", <https://api.example.com/items?page=10>; rel="last"`)
+
+	if got := resp.LinkNext(); got != "https://api.example.com/items?page=4" {
+		t.Errorf("LinkNext() = %q", got)
+	}
+	if got := resp.LinkPrev(); got != "https://api.example.com/items?page=2" {
+		t.Errorf("LinkPrev() = %q", got)
+	}
+	if got := resp.LinkFirst(); got != "https://api.example.com/items?page=1" {
+		t.Errorf("LinkFirst() = %q", got)
+	}
+	if got := resp.LinkLast(); got != "https://api.example.com/items?page=10" {
+		t.Errorf("LinkLast() = %q", got)
+	}
+
+	empty := &Response{}
+	if got := empty.LinkNext(); got != "" {
+		t.Errorf("LinkNext() with no headers = %q, want empty", got)
+	}
+}
+
 // TestResolveURL tests the URL resolution helper.
 func TestResolveURL(t *testing.T) {
 	tests := []struct {
@@ -1202,3 +1300,95 @@ func mustParseURL(rawURL string) *url.URL {
 	}
 	return u
 }
+
+func TestGetAllForAccounts(t *testing.T) {
+	var mu sync.Mutex
+	hits := map[string][]string{}
+	var inFlight, peak atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+
+		accountID, rest, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+		mu.Lock()
+		hits[accountID] = append(hits[accountID], "/"+rest)
+		mu.Unlock()
+
+		select {
+		case <-time.After(30 * time.Millisecond):
+		case <-r.Context().Done():
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `[{"account":%s}]`, accountID)
+	}))
+	defer server.Close()
+
+	client := NewClient(&Config{BaseURL: server.URL}, &mockTokenProvider{})
+	accounts := []string{"1", "2", "3", "4"}
+
+	results, err := client.GetAllForAccounts(context.Background(), accounts, "/projects.json", 2)
+	if err != nil {
+		t.Fatalf("GetAllForAccounts() error = %v", err)
+	}
+
+	if got := peak.Load(); got != 2 {
+		t.Errorf("peak concurrent requests = %d, want 2", got)
+	}
+	for _, id := range accounts {
+		if got := hits[id]; len(got) != 1 || got[0] != "/projects.json" {
+			t.Errorf("account %s hit %v, want [/projects.json]", id, got)
+		}
+		items := results[id]
+		if len(items) != 1 || string(items[0]) != fmt.Sprintf(`{"account":%s}`, id) {
+			t.Errorf("results[%s] = %s, want that account's items", id, items)
+		}
+	}
+}
+
+func TestGetAllForAccounts_ErrorCancelsOthers(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		if strings.HasPrefix(r.URL.Path, "/2/") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		select {
+		case <-time.After(5 * time.Second):
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(&Config{BaseURL: server.URL}, &mockTokenProvider{})
+
+	start := time.Now()
+	results, err := client.GetAllForAccounts(context.Background(), []string{"1", "2", "3"}, "/projects.json", 0)
+	if !IsNotFound(err) {
+		t.Fatalf("GetAllForAccounts() error = %v, want not found", err)
+	}
+	if !strings.Contains(err.Error(), "account 2") {
+		t.Errorf("error = %q, want it to name account 2", err)
+	}
+	if results != nil {
+		t.Errorf("results = %v, want nil", results)
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("took %v; in-flight requests were not cancelled", elapsed)
+	}
+}
+
+func TestGetAllForAccounts_InvalidAccountID(t *testing.T) {
+	client := NewClient(&Config{BaseURL: "https://example.invalid"}, &mockTokenProvider{})
+	_, err := client.GetAllForAccounts(context.Background(), []string{"1", "abc"}, "/projects.json", 2)
+	if ErrorCode(err) != CodeUsage {
+		t.Errorf("error = %v, want usage error", err)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/basecamp/basecamp-sdk/go/pkg/generated"
@@ -117,6 +118,7 @@ func (s *PeopleService) List(ctx context.Context, opts *PeopleListOptions) (resu
 	op := OperationInfo{
 		Service: "People", Operation: "List",
 		ResourceType: "person", IsMutation: false,
+		AccountID: s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -138,6 +140,7 @@ func (s *PeopleService) List(ctx context.Context, opts *PeopleListOptions) (resu
 
 	// Capture total count from X-Total-Count header (first page only)
 	totalCount := parseTotalCount(resp.HTTPResponse)
+	totalPages := parseTotalPages(resp.HTTPResponse, firstPageLen(resp.JSON200))
 
 	// Parse first page
 	var people []Person
@@ -149,7 +152,7 @@ func (s *PeopleService) List(ctx context.Context, opts *PeopleListOptions) (resu
 
 	// Handle single page fetch (--page flag)
 	if opts != nil && opts.Page > 0 {
-		return &PeopleListResult{People: people, Meta: ListMeta{TotalCount: totalCount}}, nil
+		return &PeopleListResult{People: people, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages}}, nil
 	}
 
 	// Determine limit: 0 = all (default for people)
@@ -160,7 +163,7 @@ func (s *PeopleService) List(ctx context.Context, opts *PeopleListOptions) (resu
 
 	// Check if we already have enough items
 	if limit > 0 && len(people) >= limit {
-		return &PeopleListResult{People: people[:limit], Meta: ListMeta{TotalCount: totalCount, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(people), limit)}}, nil
+		return &PeopleListResult{People: people[:limit], Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(people), limit)}}, nil
 	}
 
 	// Follow pagination via Link headers (uses absolute URLs from API, no path construction)
@@ -178,7 +181,71 @@ func (s *PeopleService) List(ctx context.Context, opts *PeopleListOptions) (resu
 		people = append(people, personFromGenerated(gp))
 	}
 
-	return &PeopleListResult{People: people, Meta: ListMeta{TotalCount: totalCount, Truncated: truncated}}, nil
+	return &PeopleListResult{People: people, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: truncated}}, nil
+}
+
+// ListAll returns every person visible to the current user in the account,
+// following Link headers across pages (subject to the client's MaxPages cap).
+// It is List with no Limit, so hooks observe the People.List operation.
+func (s *PeopleService) ListAll(ctx context.Context) ([]Person, error) {
+	result, err := s.List(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return result.People, nil
+}
+
+// FilterPeople returns the people for whom keep returns true, in order. The
+// result never shares a backing array with people, and is nil when nothing
+// matches. Use FindAll to filter the account's people as they are fetched.
+func FilterPeople(people []Person, keep func(Person) bool) []Person {
+	var kept []Person
+	for _, p := range people {
+		if keep(p) {
+			kept = append(kept, p)
+		}
+	}
+	return kept
+}
+
+// FindAll returns every person visible to the current user for whom match
+// returns true, in List order. It fetches all pages; hooks observe the List
+// operation.
+func (s *PeopleService) FindAll(ctx context.Context, match func(*Person) bool) ([]*Person, error) {
+	if match == nil {
+		return nil, ErrUsage("match function is required")
+	}
+	result, err := s.List(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	var found []*Person
+	for i := range result.People {
+		if match(&result.People[i]) {
+			found = append(found, &result.People[i])
+		}
+	}
+	return found, nil
+}
+
+// GetByEmail returns the person with the given email address, compared
+// case-insensitively. It returns a not-found error if nobody matches.
+// GetByEmail is built on FindAll; hooks observe the List operation.
+func (s *PeopleService) GetByEmail(ctx context.Context, email string) (*Person, error) {
+	email = strings.TrimSpace(email)
+	if email == "" {
+		return nil, ErrUsage("email address is required")
+	}
+	found, err := s.FindAll(ctx, func(p *Person) bool {
+		return strings.EqualFold(p.EmailAddress, email)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(found) == 0 {
+		return nil, ErrNotFound("person", email)
+	}
+	return found[0], nil
 }
 
 // Get returns a person by ID.
@@ -187,6 +254,7 @@ func (s *PeopleService) Get(ctx context.Context, personID int64) (result *Person
 		Service: "People", Operation: "Get",
 		ResourceType: "person", IsMutation: false,
 		ResourceID: personID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -218,6 +286,7 @@ func (s *PeopleService) Me(ctx context.Context) (result *Person, err error) {
 	op := OperationInfo{
 		Service: "People", Operation: "Me",
 		ResourceType: "person", IsMutation: false,
+		AccountID: s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -249,6 +318,7 @@ func (s *PeopleService) UpdateMyProfile(ctx context.Context, req *UpdateMyProfil
 	op := OperationInfo{
 		Service: "People", Operation: "UpdateMyProfile",
 		ResourceType: "person", IsMutation: true,
+		AccountID: s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -313,6 +383,7 @@ func (s *PeopleService) ListProjectPeople(ctx context.Context, projectID int64,
 	op := OperationInfo{
 		Service: "People", Operation: "ListProjectPeople",
 		ResourceType: "person", IsMutation: false,
+		AccountID: s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -334,6 +405,7 @@ func (s *PeopleService) ListProjectPeople(ctx context.Context, projectID int64,
 
 	// Capture total count from X-Total-Count header (first page only)
 	totalCount := parseTotalCount(resp.HTTPResponse)
+	totalPages := parseTotalPages(resp.HTTPResponse, firstPageLen(resp.JSON200))
 
 	// Parse first page
 	var people []Person
@@ -345,7 +417,7 @@ func (s *PeopleService) ListProjectPeople(ctx context.Context, projectID int64,
 
 	// Handle single page fetch (--page flag)
 	if opts != nil && opts.Page > 0 {
-		return &PeopleListResult{People: people, Meta: ListMeta{TotalCount: totalCount}}, nil
+		return &PeopleListResult{People: people, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages}}, nil
 	}
 
 	// Determine limit: 0 = all (default for people)
@@ -356,7 +428,7 @@ func (s *PeopleService) ListProjectPeople(ctx context.Context, projectID int64,
 
 	// Check if we already have enough items
 	if limit > 0 && len(people) >= limit {
-		return &PeopleListResult{People: people[:limit], Meta: ListMeta{TotalCount: totalCount, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(people), limit)}}, nil
+		return &PeopleListResult{People: people[:limit], Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(people), limit)}}, nil
 	}
 
 	// Follow pagination via Link headers (uses absolute URLs from API, no path construction)
@@ -374,7 +446,7 @@ func (s *PeopleService) ListProjectPeople(ctx context.Context, projectID int64,
 		people = append(people, personFromGenerated(gp))
 	}
 
-	return &PeopleListResult{People: people, Meta: ListMeta{TotalCount: totalCount, Truncated: truncated}}, nil
+	return &PeopleListResult{People: people, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: truncated}}, nil
 }
 
 // Pingable returns all account users who can be pinged.
@@ -389,6 +461,7 @@ func (s *PeopleService) Pingable(ctx context.Context, opts *PeopleListOptions) (
 	op := OperationInfo{
 		Service: "People", Operation: "Pingable",
 		ResourceType: "person", IsMutation: false,
+		AccountID: s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -410,6 +483,7 @@ func (s *PeopleService) Pingable(ctx context.Context, opts *PeopleListOptions) (
 
 	// Capture total count from X-Total-Count header (first page only)
 	totalCount := parseTotalCount(resp.HTTPResponse)
+	totalPages := parseTotalPages(resp.HTTPResponse, firstPageLen(resp.JSON200))
 
 	// Parse first page
 	var people []Person
@@ -421,7 +495,7 @@ func (s *PeopleService) Pingable(ctx context.Context, opts *PeopleListOptions) (
 
 	// Handle single page fetch (--page flag)
 	if opts != nil && opts.Page > 0 {
-		return &PeopleListResult{People: people, Meta: ListMeta{TotalCount: totalCount}}, nil
+		return &PeopleListResult{People: people, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages}}, nil
 	}
 
 	// Determine limit: 0 = all (default for people)
@@ -432,7 +506,7 @@ func (s *PeopleService) Pingable(ctx context.Context, opts *PeopleListOptions) (
 
 	// Check if we already have enough items
 	if limit > 0 && len(people) >= limit {
-		return &PeopleListResult{People: people[:limit], Meta: ListMeta{TotalCount: totalCount, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(people), limit)}}, nil
+		return &PeopleListResult{People: people[:limit], Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(people), limit)}}, nil
 	}
 
 	// Follow pagination via Link headers (uses absolute URLs from API, no path construction)
@@ -450,7 +524,7 @@ func (s *PeopleService) Pingable(ctx context.Context, opts *PeopleListOptions) (
 		people = append(people, personFromGenerated(gp))
 	}
 
-	return &PeopleListResult{People: people, Meta: ListMeta{TotalCount: totalCount, Truncated: truncated}}, nil
+	return &PeopleListResult{People: people, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: truncated}}, nil
 }
 
 // UpdateProjectAccess grants or revokes project access for people.
@@ -459,6 +533,7 @@ func (s *PeopleService) UpdateProjectAccess(ctx context.Context, projectID int64
 	op := OperationInfo{
 		Service: "People", Operation: "UpdateProjectAccess",
 		ResourceType: "person", IsMutation: true,
+		AccountID: s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -474,6 +549,104 @@ func (s *PeopleService) UpdateProjectAccess(ctx context.Context, projectID int64
 		return nil, err
 	}
 
+	return s.updateProjectAccess(ctx, projectID, req)
+}
+
+// GrantAccess grants the given existing people access to a project.
+// To invite people who don't have an account yet, use Invite.
+func (s *PeopleService) GrantAccess(ctx context.Context, projectID int64, personIDs []int64) (err error) {
+	op := OperationInfo{
+		Service: "People", Operation: "GrantAccess",
+		ResourceType: "person", IsMutation: true,
+		AccountID: s.client.accountID,
+	}
+	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
+		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
+			return
+		}
+	}
+	start := time.Now()
+	ctx = s.client.parent.hooks.OnOperationStart(ctx, op)
+	defer func() { s.client.parent.hooks.OnOperationEnd(ctx, op, err, time.Since(start)) }()
+
+	if len(personIDs) == 0 {
+		err = ErrUsage("at least one person ID is required")
+		return err
+	}
+
+	_, err = s.updateProjectAccess(ctx, projectID, &UpdateProjectAccessRequest{Grant: personIDs})
+	return err
+}
+
+// RevokeAccess removes the given people from a project.
+func (s *PeopleService) RevokeAccess(ctx context.Context, projectID int64, personIDs []int64) (err error) {
+	op := OperationInfo{
+		Service: "People", Operation: "RevokeAccess",
+		ResourceType: "person", IsMutation: true,
+		AccountID: s.client.accountID,
+	}
+	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
+		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
+			return
+		}
+	}
+	start := time.Now()
+	ctx = s.client.parent.hooks.OnOperationStart(ctx, op)
+	defer func() { s.client.parent.hooks.OnOperationEnd(ctx, op, err, time.Since(start)) }()
+
+	if len(personIDs) == 0 {
+		err = ErrUsage("at least one person ID is required")
+		return err
+	}
+
+	_, err = s.updateProjectAccess(ctx, projectID, &UpdateProjectAccessRequest{Revoke: personIDs})
+	return err
+}
+
+// Invite adds a person to a project by email, creating their account if they
+// don't have one yet. Returns the invited person; inviting someone who already
+// exists returns their existing record.
+func (s *PeopleService) Invite(ctx context.Context, projectID int64, req *CreatePersonRequest) (result *Person, err error) {
+	op := OperationInfo{
+		Service: "People", Operation: "Invite",
+		ResourceType: "person", IsMutation: true,
+		AccountID: s.client.accountID,
+	}
+	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
+		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
+			return
+		}
+	}
+	start := time.Now()
+	ctx = s.client.parent.hooks.OnOperationStart(ctx, op)
+	defer func() { s.client.parent.hooks.OnOperationEnd(ctx, op, err, time.Since(start)) }()
+
+	if req == nil || req.EmailAddress == "" {
+		err = ErrUsage("email address is required")
+		return nil, err
+	}
+	if req.Name == "" {
+		err = ErrUsage("name is required")
+		return nil, err
+	}
+
+	access, err := s.updateProjectAccess(ctx, projectID, &UpdateProjectAccessRequest{Create: []CreatePersonRequest{*req}})
+	if err != nil {
+		return nil, err
+	}
+	for i := range access.Granted {
+		if strings.EqualFold(access.Granted[i].EmailAddress, req.EmailAddress) {
+			return &access.Granted[i], nil
+		}
+	}
+	err = fmt.Errorf("invited person %s missing from response", req.EmailAddress)
+	return nil, err
+}
+
+// updateProjectAccess performs the project access update shared by
+// UpdateProjectAccess, GrantAccess, and RevokeAccess. Callers own the
+// operation hooks and request validation.
+func (s *PeopleService) updateProjectAccess(ctx context.Context, projectID int64, req *UpdateProjectAccessRequest) (*UpdateProjectAccessResponse, error) {
 	body := generated.UpdateProjectAccessJSONRequestBody{
 		Grant:  req.Grant,
 		Revoke: req.Revoke,
@@ -498,8 +671,7 @@ func (s *PeopleService) UpdateProjectAccess(ctx context.Context, projectID int64
 		return nil, err
 	}
 	if resp.JSON200 == nil {
-		err = fmt.Errorf("unexpected empty response")
-		return nil, err
+		return nil, fmt.Errorf("unexpected empty response")
 	}
 
 	// Convert the response
@@ -565,6 +737,7 @@ func (s *PeopleService) GetMyPreferences(ctx context.Context) (result *Preferenc
 	op := OperationInfo{
 		Service: "People", Operation: "GetMyPreferences",
 		ResourceType: "preferences", IsMutation: false,
+		AccountID: s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -596,6 +769,7 @@ func (s *PeopleService) UpdateMyPreferences(ctx context.Context, req *UpdateMyPr
 	op := OperationInfo{
 		Service: "People", Operation: "UpdateMyPreferences",
 		ResourceType: "preferences", IsMutation: true,
+		AccountID: s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -632,6 +806,7 @@ func (s *PeopleService) GetOutOfOffice(ctx context.Context, personID int64) (res
 		Service: "People", Operation: "GetOutOfOffice",
 		ResourceType: "out_of_office", IsMutation: false,
 		ResourceID: personID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -664,6 +839,7 @@ func (s *PeopleService) EnableOutOfOffice(ctx context.Context, personID int64, r
 		Service: "People", Operation: "EnableOutOfOffice",
 		ResourceType: "out_of_office", IsMutation: true,
 		ResourceID: personID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -716,6 +892,7 @@ func (s *PeopleService) DisableOutOfOffice(ctx context.Context, personID int64)
 		Service: "People", Operation: "DisableOutOfOffice",
 		ResourceType: "out_of_office", IsMutation: true,
 		ResourceID: personID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -3,10 +3,14 @@ package basecamp
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
 	"testing"
 )
 
@@ -340,7 +344,7 @@ func TestCreatePersonRequest_MarshalOmitsEmpty(t *testing.T) {
 	}
 }
 
-func testPeopleServer(t *testing.T, handler http.HandlerFunc) *PeopleService {
+func testPeopleServer(t *testing.T, handler http.HandlerFunc, opts ...ClientOption) *PeopleService {
 	t.Helper()
 	server := httptest.NewServer(handler)
 	t.Cleanup(server.Close)
@@ -348,7 +352,7 @@ func testPeopleServer(t *testing.T, handler http.HandlerFunc) *PeopleService {
 	cfg := DefaultConfig()
 	cfg.BaseURL = server.URL
 	token := &StaticTokenProvider{Token: "test-token"}
-	client := NewClient(cfg, token)
+	client := NewClient(cfg, token, opts...)
 	account := client.ForAccount("99999")
 	return account.People()
 }
@@ -441,6 +445,91 @@ func TestPeopleService_UpdateMyProfileClearsField(t *testing.T) {
 	}
 }
 
+func TestPeopleService_GrantAccess(t *testing.T) {
+	var receivedBody map[string]any
+	hooks := &recordingHooks{}
+	svc := testPeopleServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" {
+			t.Errorf("expected PUT, got %s", r.Method)
+		}
+		if r.URL.Path != "/99999/projects/123/people/users.json" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		receivedBody = decodeRequestBody(t, r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		w.Write([]byte(`{"granted":[{"id":1,"name":"Annie"},{"id":2,"name":"Victor"}],"revoked":[]}`))
+	}, WithHooks(hooks))
+
+	if err := svc.GrantAccess(context.Background(), 123, []int64{1, 2}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	grant, ok := receivedBody["grant"].([]any)
+	if !ok || len(grant) != 2 || grant[0] != json.Number("1") || grant[1] != json.Number("2") {
+		t.Errorf("expected grant [1 2], got %v", receivedBody["grant"])
+	}
+	for _, field := range []string{"revoke", "create"} {
+		if _, ok := receivedBody[field]; ok {
+			t.Errorf("expected %q to be omitted, got %v", field, receivedBody[field])
+		}
+	}
+
+	if len(hooks.opStartCalls) != 1 {
+		t.Fatalf("expected 1 operation start, got %d", len(hooks.opStartCalls))
+	}
+	if op := hooks.opStartCalls[0]; op.Operation != "GrantAccess" || !op.IsMutation {
+		t.Errorf("unexpected operation info: %+v", op)
+	}
+}
+
+func TestPeopleService_RevokeAccess(t *testing.T) {
+	var receivedBody map[string]any
+	hooks := &recordingHooks{}
+	svc := testPeopleServer(t, func(w http.ResponseWriter, r *http.Request) {
+		receivedBody = decodeRequestBody(t, r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		w.Write([]byte(`{"granted":[],"revoked":[{"id":3,"name":"Former"}]}`))
+	}, WithHooks(hooks))
+
+	if err := svc.RevokeAccess(context.Background(), 123, []int64{3}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	revoke, ok := receivedBody["revoke"].([]any)
+	if !ok || len(revoke) != 1 || revoke[0] != json.Number("3") {
+		t.Errorf("expected revoke [3], got %v", receivedBody["revoke"])
+	}
+	if _, ok := receivedBody["grant"]; ok {
+		t.Errorf("expected grant to be omitted, got %v", receivedBody["grant"])
+	}
+
+	if len(hooks.opStartCalls) != 1 {
+		t.Fatalf("expected 1 operation start, got %d", len(hooks.opStartCalls))
+	}
+	if op := hooks.opStartCalls[0]; op.Operation != "RevokeAccess" || !op.IsMutation {
+		t.Errorf("unexpected operation info: %+v", op)
+	}
+}
+
+func TestPeopleService_GrantRevokeAccess_RequirePersonIDs(t *testing.T) {
+	svc := testPeopleServer(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("no request expected when person IDs are empty")
+	})
+
+	for name, call := range map[string]func() error{
+		"GrantAccess":  func() error { return svc.GrantAccess(context.Background(), 123, nil) },
+		"RevokeAccess": func() error { return svc.RevokeAccess(context.Background(), 123, []int64{}) },
+	} {
+		err := call()
+		apiErr, ok := errors.AsType[*Error](err)
+		if !ok || apiErr.Code != CodeUsage {
+			t.Errorf("%s: expected usage error, got %v", name, err)
+		}
+	}
+}
+
 func TestOutOfOffice_Unmarshal(t *testing.T) {
 	data := loadPeopleFixture(t, "out-of-office.json")
 
@@ -494,3 +583,211 @@ func TestOutOfOffice_UnmarshalDisabled(t *testing.T) {
 			ooo.StartDate, ooo.EndDate, ooo.BackOnDate)
 	}
 }
+
+func TestPeopleService_Invite(t *testing.T) {
+	var receivedBody map[string]any
+	svc := testPeopleServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" {
+			t.Errorf("expected PUT, got %s", r.Method)
+		}
+		if r.URL.Path != "/99999/projects/123/people/users.json" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		receivedBody = decodeRequestBody(t, r)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		w.Write([]byte(`{"granted":[{"id":7,"name":"Jane Doe","email_address":"jane@example.com","company":{"id":1,"name":"Acme"}}],"revoked":[]}`))
+	})
+
+	person, err := svc.Invite(context.Background(), 123, &CreatePersonRequest{
+		Name:         "Jane Doe",
+		EmailAddress: "jane@example.com",
+		CompanyName:  "Acme",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if person.ID != 7 || person.Name != "Jane Doe" {
+		t.Errorf("unexpected person: %+v", person)
+	}
+
+	create, ok := receivedBody["create"].([]any)
+	if !ok || len(create) != 1 {
+		t.Fatalf("expected one create entry, got %v", receivedBody["create"])
+	}
+	entry := create[0].(map[string]any)
+	if entry["name"] != "Jane Doe" || entry["email_address"] != "jane@example.com" || entry["company_name"] != "Acme" {
+		t.Errorf("unexpected create entry: %v", entry)
+	}
+}
+
+func TestPeopleService_Invite_ExistingPerson(t *testing.T) {
+	svc := testPeopleServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(200)
+		// An existing person is granted access and returned as-is; match by email case-insensitively.
+		w.Write([]byte(`{"granted":[{"id":1,"name":"Other","email_address":"other@example.com"},{"id":42,"name":"Jane Doe","email_address":"Jane@Example.com"}],"revoked":[]}`))
+	})
+
+	person, err := svc.Invite(context.Background(), 123, &CreatePersonRequest{Name: "Jane Doe", EmailAddress: "jane@example.com"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if person.ID != 42 {
+		t.Errorf("expected existing person 42, got %d", person.ID)
+	}
+}
+
+func TestPeopleService_Invite_RequiresEmailAndName(t *testing.T) {
+	svc := testPeopleServer(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("no request expected for invalid input")
+	})
+
+	for _, req := range []*CreatePersonRequest{nil, {Name: "Jane"}, {EmailAddress: "jane@example.com"}} {
+		_, err := svc.Invite(context.Background(), 123, req)
+		if apiErr, ok := errors.AsType[*Error](err); !ok || apiErr.Code != CodeUsage {
+			t.Errorf("Invite(%+v): expected usage error, got %v", req, err)
+		}
+	}
+}
+
+// pagedPeopleServer serves the people list fixture one person per page.
+func pagedPeopleServer(t *testing.T) *PeopleService {
+	t.Helper()
+	var people []json.RawMessage
+	if err := json.Unmarshal(loadPeopleFixture(t, "list.json"), &people); err != nil {
+		t.Fatal(err)
+	}
+	return testPeopleServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/99999/people.json" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		page := 1
+		if p := r.URL.Query().Get("page"); p != "" {
+			page, _ = strconv.Atoi(p)
+		}
+		if page < len(people) {
+			w.Header().Set("Link", fmt.Sprintf(`<http://%s/99999/people.json?page=%d>; rel="next"`, r.Host, page+1))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, "[%s]", people[page-1])
+	})
+}
+
+func TestPeopleService_GetByEmail(t *testing.T) {
+	svc := pagedPeopleServer(t)
+
+	// steve@ is on the second page.
+	person, err := svc.GetByEmail(context.Background(), "Steve@HonchoDesign.com")
+	if err != nil {
+		t.Fatalf("GetByEmail() error = %v", err)
+	}
+	if person.ID != 1049715920 {
+		t.Errorf("GetByEmail() ID = %d, want 1049715920", person.ID)
+	}
+}
+
+func TestPeopleService_GetByEmail_NotFound(t *testing.T) {
+	svc := pagedPeopleServer(t)
+
+	_, err := svc.GetByEmail(context.Background(), "nobody@example.com")
+	if !IsNotFound(err) {
+		t.Errorf("GetByEmail() error = %v, want not found", err)
+	}
+}
+
+func TestPeopleService_FindAll(t *testing.T) {
+	svc := pagedPeopleServer(t)
+
+	found, err := svc.FindAll(context.Background(), func(p *Person) bool {
+		return strings.HasSuffix(p.EmailAddress, "@honchodesign.com")
+	})
+	if err != nil {
+		t.Fatalf("FindAll() error = %v", err)
+	}
+	if len(found) != 2 {
+		t.Fatalf("FindAll() returned %d people, want 2", len(found))
+	}
+
+	none, err := svc.FindAll(context.Background(), func(p *Person) bool { return false })
+	if err != nil || len(none) != 0 {
+		t.Errorf("FindAll(no match) = %v, %v; want empty", none, err)
+	}
+}
+
+func TestPeopleService_ListAll(t *testing.T) {
+	var requests int
+	svc := testPeopleServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		if page == 0 {
+			page = 1
+		}
+		if page < 3 {
+			w.Header().Set("Link", fmt.Sprintf(`<http://%s/99999/people.json?page=%d>; rel="next"`, r.Host, page+1))
+		}
+		people := make([]map[string]any, 50)
+		for i := range people {
+			id := (page-1)*50 + i + 1
+			people[i] = map[string]any{"id": id, "name": fmt.Sprintf("Person %d", id)}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(people)
+	})
+
+	people, err := svc.ListAll(context.Background())
+	if err != nil {
+		t.Fatalf("ListAll() error = %v", err)
+	}
+	if requests != 3 {
+		t.Errorf("expected 3 requests, got %d", requests)
+	}
+	if len(people) != 150 {
+		t.Fatalf("expected 150 people, got %d", len(people))
+	}
+	if people[0].ID != 1 || people[149].ID != 150 {
+		t.Errorf("unexpected IDs: first %d, last %d", people[0].ID, people[149].ID)
+	}
+}
+
+func TestPerson_DisplayName(t *testing.T) {
+	tests := []struct {
+		person Person
+		want   string
+	}{
+		{Person{Name: "Victor Cooper", EmailAddress: "victor@honchodesign.com"}, "Victor Cooper"},
+		{Person{EmailAddress: "victor@honchodesign.com"}, "victor@honchodesign.com"},
+		{Person{}, ""},
+	}
+	for _, tt := range tests {
+		if got := tt.person.DisplayName(); got != tt.want {
+			t.Errorf("%+v.DisplayName() = %q, want %q", tt.person, got, tt.want)
+		}
+	}
+}
+
+func TestFilterPeople(t *testing.T) {
+	people := []Person{
+		{ID: 1, Name: "Victor Cooper"},
+		{ID: 2, Name: "Annie Bryan", Client: true},
+		{ID: 3, Name: "Andrew Wong"},
+	}
+
+	staff := FilterPeople(people, func(p Person) bool { return !p.Client })
+	if len(staff) != 2 || staff[0].ID != 1 || staff[1].ID != 3 {
+		t.Fatalf("FilterPeople() = %+v, want IDs 1 and 3", staff)
+	}
+
+	all := FilterPeople(people, func(Person) bool { return true })
+	all[0].Name = "changed"
+	if people[0].Name != "Victor Cooper" {
+		t.Error("FilterPeople() result shares its backing array with the input")
+	}
+
+	if got := FilterPeople(nil, func(Person) bool { return true }); len(got) != 0 {
+		t.Errorf("FilterPeople(nil) = %+v, want empty", got)
+	}
+	if got := FilterPeople(people, func(Person) bool { return false }); got != nil {
+		t.Errorf("FilterPeople(no match) = %+v, want nil", got)
+	}
+}
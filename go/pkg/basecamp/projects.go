@@ -137,6 +137,7 @@ func (s *ProjectsService) List(ctx context.Context, opts *ProjectListOptions) (r
 	op := OperationInfo{
 		Service: "Projects", Operation: "List",
 		ResourceType: "project", IsMutation: false,
+		AccountID: s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -164,6 +165,7 @@ func (s *ProjectsService) List(ctx context.Context, opts *ProjectListOptions) (r
 
 	// Capture total count from X-Total-Count header (first page only)
 	totalCount := parseTotalCount(resp.HTTPResponse)
+	totalPages := parseTotalPages(resp.HTTPResponse, firstPageLen(resp.JSON200))
 
 	// Parse first page
 	var projects []Project
@@ -175,7 +177,7 @@ func (s *ProjectsService) List(ctx context.Context, opts *ProjectListOptions) (r
 
 	// Handle single page fetch (--page flag)
 	if opts != nil && opts.Page > 0 {
-		return &ProjectListResult{Projects: projects, Meta: ListMeta{TotalCount: totalCount}}, nil
+		return &ProjectListResult{Projects: projects, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages}}, nil
 	}
 
 	// Determine limit: 0 = all (default for projects)
@@ -186,7 +188,7 @@ func (s *ProjectsService) List(ctx context.Context, opts *ProjectListOptions) (r
 
 	// Check if we already have enough items
 	if limit > 0 && len(projects) >= limit {
-		return &ProjectListResult{Projects: projects[:limit], Meta: ListMeta{TotalCount: totalCount, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(projects), limit)}}, nil
+		return &ProjectListResult{Projects: projects[:limit], Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(projects), limit)}}, nil
 	}
 
 	// Follow pagination via Link headers (uses absolute URLs from API, no path construction)
@@ -204,7 +206,28 @@ func (s *ProjectsService) List(ctx context.Context, opts *ProjectListOptions) (r
 		projects = append(projects, projectFromGenerated(gp))
 	}
 
-	return &ProjectListResult{Projects: projects, Meta: ListMeta{TotalCount: totalCount, Truncated: truncated}}, nil
+	return &ProjectListResult{Projects: projects, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: truncated}}, nil
+}
+
+// ListActive returns all active projects, following all pages.
+// It is List with Status: ProjectStatusActive; hooks observe the
+// Projects.List operation.
+func (s *ProjectsService) ListActive(ctx context.Context) (*ProjectListResult, error) {
+	return s.List(ctx, &ProjectListOptions{Status: ProjectStatusActive})
+}
+
+// ListArchived returns all archived projects, following all pages.
+// It is List with Status: ProjectStatusArchived; hooks observe the
+// Projects.List operation.
+func (s *ProjectsService) ListArchived(ctx context.Context) (*ProjectListResult, error) {
+	return s.List(ctx, &ProjectListOptions{Status: ProjectStatusArchived})
+}
+
+// ListTrashed returns all trashed projects, following all pages.
+// It is List with Status: ProjectStatusTrashed; hooks observe the
+// Projects.List operation.
+func (s *ProjectsService) ListTrashed(ctx context.Context) (*ProjectListResult, error) {
+	return s.List(ctx, &ProjectListOptions{Status: ProjectStatusTrashed})
 }
 
 // Get returns a project by ID.
@@ -213,6 +236,7 @@ func (s *ProjectsService) Get(ctx context.Context, id int64) (result *Project, e
 		Service: "Projects", Operation: "Get",
 		ResourceType: "project", IsMutation: false,
 		ResourceID: id,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -239,12 +263,42 @@ func (s *ProjectsService) Get(ctx context.Context, id int64) (result *Project, e
 	return &project, nil
 }
 
+// GetByURL returns the project referenced by a Basecamp URL, such as
+// https://3.basecamp.com/12345/projects/67890 or any URL inside the project.
+// The URL's account must match this client's account.
+//
+// GetByURL parses the URL with ParseBasecampURL and then calls Get; hooks
+// observe the Get operation.
+func (s *ProjectsService) GetByURL(ctx context.Context, rawURL string) (*Project, error) {
+	accountID, projectID, err := ParseBasecampURL(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	if accountID != s.client.accountID {
+		return nil, ErrUsage(fmt.Sprintf("URL is for account %s, but this client is for account %s", accountID, s.client.accountID))
+	}
+	return s.Get(ctx, projectID)
+}
+
+// ListPeople returns everyone with access to a project, following all pages.
+//
+// ListPeople is a convenience for People().ListProjectPeople with no limit;
+// hooks observe the People.ListProjectPeople operation.
+func (s *ProjectsService) ListPeople(ctx context.Context, projectID int64) ([]Person, error) {
+	result, err := s.client.People().ListProjectPeople(ctx, projectID, nil)
+	if err != nil {
+		return nil, err
+	}
+	return result.People, nil
+}
+
 // Create creates a new project.
 // Returns the created project.
 func (s *ProjectsService) Create(ctx context.Context, req *CreateProjectRequest) (result *Project, err error) {
 	op := OperationInfo{
 		Service: "Projects", Operation: "Create",
 		ResourceType: "project", IsMutation: true,
+		AccountID: s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -288,6 +342,7 @@ func (s *ProjectsService) Update(ctx context.Context, id int64, req *UpdateProje
 		Service: "Projects", Operation: "Update",
 		ResourceType: "project", IsMutation: true,
 		ResourceID: id,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -352,6 +407,7 @@ func (s *ProjectsService) Trash(ctx context.Context, id int64) (err error) {
 		Service: "Projects", Operation: "Trash",
 		ResourceType: "project", IsMutation: true,
 		ResourceID: id,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -3,6 +3,8 @@ package basecamp
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"os"
@@ -322,3 +324,115 @@ func TestProjectsService_UpdateEmptyScheduleAttributes(t *testing.T) {
 		t.Errorf("expected schedule_attributes to be omitted for empty struct, but it was present: %v", receivedBody["schedule_attributes"])
 	}
 }
+
+func TestProjectsService_GetByURL(t *testing.T) {
+	fixture := loadFixture(t, "get.json")
+	svc := testProjectsServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/99999/projects/67890" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(fixture)
+	})
+
+	project, err := svc.GetByURL(context.Background(), "https://3.basecamp.com/99999/buckets/67890/todos/1")
+	if err != nil {
+		t.Fatalf("GetByURL() error = %v", err)
+	}
+	if project.ID == 0 {
+		t.Error("expected project to be decoded")
+	}
+}
+
+func TestProjectsService_GetByURL_AccountMismatch(t *testing.T) {
+	svc := testProjectsServer(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request: %s", r.URL.Path)
+	})
+
+	_, err := svc.GetByURL(context.Background(), "https://3.basecamp.com/12345/projects/67890")
+	if apiErr, ok := errors.AsType[*Error](err); !ok || apiErr.Code != CodeUsage {
+		t.Errorf("GetByURL() error = %v, want usage error", err)
+	}
+}
+
+func TestProjectsService_ListPeople(t *testing.T) {
+	var people []map[string]any
+	if err := json.Unmarshal(loadPeopleFixture(t, "list.json"), &people); err != nil {
+		t.Fatal(err)
+	}
+	if len(people) < 2 {
+		t.Fatalf("people fixture has %d entries, need at least 2", len(people))
+	}
+	page1, _ := json.Marshal(people[:1])
+	page2, _ := json.Marshal(people[1:])
+
+	svc := testProjectsServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/99999/projects/67890/people.json" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("page") == "2" {
+			w.Write(page2)
+			return
+		}
+		w.Header().Set("Link", fmt.Sprintf(`<http://%s/99999/projects/67890/people.json?page=2>; rel="next"`, r.Host))
+		w.Write(page1)
+	})
+
+	got, err := svc.ListPeople(context.Background(), 67890)
+	if err != nil {
+		t.Fatalf("ListPeople() error = %v", err)
+	}
+	if len(got) != len(people) {
+		t.Fatalf("ListPeople() returned %d people, want %d", len(got), len(people))
+	}
+	if got[0].EmailAddress == "" || got[0].AvatarURL == "" {
+		t.Errorf("expected EmailAddress and AvatarURL to be decoded, got %+v", got[0])
+	}
+}
+
+func TestProjectsService_ListByStatus(t *testing.T) {
+	tests := []struct {
+		status string
+		list   func(*ProjectsService) (*ProjectListResult, error)
+	}{
+		{"active", func(s *ProjectsService) (*ProjectListResult, error) { return s.ListActive(context.Background()) }},
+		{"archived", func(s *ProjectsService) (*ProjectListResult, error) { return s.ListArchived(context.Background()) }},
+		{"trashed", func(s *ProjectsService) (*ProjectListResult, error) { return s.ListTrashed(context.Background()) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.status, func(t *testing.T) {
+			var requests int
+			svc := testProjectsServer(t, func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				if got := r.URL.Query().Get("status"); got != tt.status {
+					t.Errorf("status query = %q, want %q", got, tt.status)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				if r.URL.Query().Get("page") == "2" {
+					fmt.Fprintf(w, `[{"id":2,"name":"Second","status":%q}]`, tt.status)
+					return
+				}
+				w.Header().Set("Link", fmt.Sprintf(`<http://%s/99999/projects.json?status=%s&page=2>; rel="next"`, r.Host, tt.status))
+				fmt.Fprintf(w, `[{"id":1,"name":"First","status":%q}]`, tt.status)
+			})
+
+			result, err := tt.list(svc)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if requests != 2 {
+				t.Errorf("expected 2 page requests, got %d", requests)
+			}
+			if len(result.Projects) != 2 {
+				t.Fatalf("expected 2 projects, got %d", len(result.Projects))
+			}
+			for _, p := range result.Projects {
+				if p.Status != tt.status {
+					t.Errorf("project %d status = %q, want %q", p.ID, p.Status, tt.status)
+				}
+			}
+		})
+	}
+}
@@ -13,10 +13,18 @@
 //
 //	hooks := basecampprom.NewHooks(prometheus.DefaultRegisterer)
 //	client := basecamp.NewClient(cfg, tokenProvider, basecamp.WithHooks(hooks))
+//
+// To also export ETag cache statistics, pass the client's cache:
+//
+//	cache := basecamp.NewCache(cfg.CacheDir)
+//	hooks := basecampprom.NewHooks(prometheus.DefaultRegisterer, basecampprom.WithCache(cache))
+//	client := basecamp.NewClient(cfg, tokenProvider,
+//	    basecamp.WithCache(cache), basecamp.WithHooks(hooks))
 package prometheus
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -36,18 +44,102 @@ type Hooks struct {
 	retriesTotal      *prometheus.CounterVec
 	cacheOpsTotal     *prometheus.CounterVec
 	errorsTotal       *prometheus.CounterVec
+	responseBodyBytes *prometheus.HistogramVec
 }
 
+// ResponseSizeBuckets are the default bucket boundaries, in bytes, of the
+// basecamp_response_body_bytes histogram: 512 B, 4 KiB, 64 KiB, 1 MiB, 10 MiB.
+var ResponseSizeBuckets = []float64{512, 4096, 65536, 1048576, 10485760}
+
+// PromConfig sets the histogram buckets used by NewHooksWithConfig.
+type PromConfig struct {
+	// DurationBuckets are the bucket boundaries, in seconds, of
+	// basecamp_operation_duration_seconds.
+	DurationBuckets []float64
+
+	// ResponseSizeBuckets are the bucket boundaries, in bytes, of
+	// basecamp_response_body_bytes.
+	ResponseSizeBuckets []float64
+}
+
+// DefaultPromConfig returns the buckets NewHooks uses: prometheus.DefBuckets
+// for durations and ResponseSizeBuckets for response sizes.
+func DefaultPromConfig() PromConfig {
+	return PromConfig{
+		DurationBuckets:     prometheus.DefBuckets,
+		ResponseSizeBuckets: ResponseSizeBuckets,
+	}
+}
+
+// Validate reports an error if either bucket list is empty or not strictly
+// ascending, which would otherwise make metric registration panic.
+func (c PromConfig) Validate() error {
+	if err := validateBuckets("DurationBuckets", c.DurationBuckets); err != nil {
+		return err
+	}
+	return validateBuckets("ResponseSizeBuckets", c.ResponseSizeBuckets)
+}
+
+func validateBuckets(name string, buckets []float64) error {
+	if len(buckets) == 0 {
+		return fmt.Errorf("basecampprom: %s must not be empty", name)
+	}
+	for i := 1; i < len(buckets); i++ {
+		if buckets[i] <= buckets[i-1] {
+			return fmt.Errorf("basecampprom: %s must be in strictly ascending order, got %v", name, buckets)
+		}
+	}
+	return nil
+}
+
+// operationKey is the context key for the operation name recorded by
+// OnOperationStart, used to label request-level metrics.
+type operationKey struct{}
+
 // Ensure Hooks implements basecamp.Hooks at compile time.
 var _ basecamp.Hooks = (*Hooks)(nil)
 
+// Option configures Hooks.
+type Option func(*hooksConfig)
+
+type hooksConfig struct {
+	cache *basecamp.Cache
+}
+
+// WithCache exports the given cache's Stats as basecamp_cache_hits_total,
+// basecamp_cache_misses_total, basecamp_cache_stores_total,
+// basecamp_cache_evictions_total, and basecamp_cache_size_bytes.
+// Pass the same cache given to basecamp.WithCache.
+func WithCache(cache *basecamp.Cache) Option {
+	return func(c *hooksConfig) {
+		c.cache = cache
+	}
+}
+
 // NewHooks creates a new Prometheus-based Hooks implementation.
 // The registerer is used to register the metrics. Use prometheus.DefaultRegisterer
 // for the global registry, or pass a custom registry for testing.
 // Returns nil if registerer is nil.
-func NewHooks(registerer prometheus.Registerer) *Hooks {
+func NewHooks(registerer prometheus.Registerer, opts ...Option) *Hooks {
+	// The default buckets always validate.
+	h, _ := NewHooksWithConfig(registerer, DefaultPromConfig(), opts...)
+	return h
+}
+
+// NewHooksWithConfig is like NewHooks but uses the histogram buckets in
+// promCfg. It returns an error, without registering anything, if promCfg
+// fails Validate. Returns nil and no error if registerer is nil.
+func NewHooksWithConfig(registerer prometheus.Registerer, promCfg PromConfig, opts ...Option) (*Hooks, error) {
 	if registerer == nil {
-		return nil
+		return nil, nil
+	}
+	if err := promCfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	var cfg hooksConfig
+	for _, opt := range opts {
+		opt(&cfg)
 	}
 
 	h := &Hooks{
@@ -56,9 +148,9 @@ func NewHooks(registerer prometheus.Registerer) *Hooks {
 				Namespace: namespace,
 				Name:      "operation_duration_seconds",
 				Help:      "Duration of Basecamp API operations in seconds.",
-				Buckets:   prometheus.DefBuckets,
+				Buckets:   promCfg.DurationBuckets,
 			},
-			[]string{"operation"}, // Semantic operation name (e.g., "Todos.Complete")
+			[]string{"operation", "account_id"}, // Semantic operation name (e.g., "Todos.Complete")
 		),
 		operationsTotal: prometheus.NewCounterVec(
 			prometheus.CounterOpts{
@@ -66,7 +158,7 @@ func NewHooks(registerer prometheus.Registerer) *Hooks {
 				Name:      "operations_total",
 				Help:      "Total number of Basecamp API operations.",
 			},
-			[]string{"operation", "status"}, // Semantic operation name
+			[]string{"operation", "account_id", "status"}, // Semantic operation name
 		),
 		httpRequestsTotal: prometheus.NewCounterVec(
 			prometheus.CounterOpts{
@@ -100,6 +192,15 @@ func NewHooks(registerer prometheus.Registerer) *Hooks {
 			},
 			[]string{"http_method", "type"}, // HTTP method
 		),
+		responseBodyBytes: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Namespace: namespace,
+				Name:      "response_body_bytes",
+				Help:      "Size of Basecamp API response bodies in bytes.",
+				Buckets:   promCfg.ResponseSizeBuckets,
+			},
+			[]string{"operation", "http_method"}, // operation is "" outside an SDK operation
+		),
 	}
 
 	// Register all metrics
@@ -110,29 +211,64 @@ func NewHooks(registerer prometheus.Registerer) *Hooks {
 		h.retriesTotal,
 		h.cacheOpsTotal,
 		h.errorsTotal,
+		h.responseBodyBytes,
 	)
 
-	return h
+	if cfg.cache != nil {
+		registerCacheStats(registerer, cfg.cache)
+	}
+
+	return h, nil
+}
+
+// registerCacheStats registers collectors that read the cache's Stats on scrape.
+func registerCacheStats(registerer prometheus.Registerer, cache *basecamp.Cache) {
+	counter := func(name, help string, value func(basecamp.CacheStats) int64) prometheus.Collector {
+		return prometheus.NewCounterFunc(
+			prometheus.CounterOpts{Namespace: namespace, Name: name, Help: help},
+			func() float64 { return float64(value(cache.Stats())) },
+		)
+	}
+
+	registerer.MustRegister(
+		counter("cache_hits_total", "Total number of cached GET requests answered from the cache.",
+			func(s basecamp.CacheStats) int64 { return s.Hits }),
+		counter("cache_misses_total", "Total number of cached GET requests not answered from the cache.",
+			func(s basecamp.CacheStats) int64 { return s.Misses }),
+		counter("cache_stores_total", "Total number of responses stored in the cache.",
+			func(s basecamp.CacheStats) int64 { return s.Stores }),
+		counter("cache_evictions_total", "Total number of entries removed from the cache.",
+			func(s basecamp.CacheStats) int64 { return s.Evictions }),
+		prometheus.NewGaugeFunc(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "cache_size_bytes",
+				Help:      "Total size of cached response bodies in bytes.",
+			},
+			func() float64 { return float64(cache.Stats().SizeBytes) },
+		),
+	)
 }
 
 // OnOperationStart is called when a semantic SDK operation begins.
-// Returns the context unchanged as Prometheus hooks don't need to track state.
+// It records the operation name in the context so the requests the operation
+// makes can be labeled with it.
 func (h *Hooks) OnOperationStart(ctx context.Context, op basecamp.OperationInfo) context.Context {
-	return ctx
+	return context.WithValue(ctx, operationKey{}, op.Service+"."+op.Operation)
 }
 
 // OnOperationEnd records metrics for a completed SDK operation.
 func (h *Hooks) OnOperationEnd(ctx context.Context, op basecamp.OperationInfo, err error, duration time.Duration) {
 	// Record operation duration by service and operation name
 	operation := op.Service + "." + op.Operation
-	h.operationDuration.WithLabelValues(operation).Observe(duration.Seconds())
+	h.operationDuration.WithLabelValues(operation, op.AccountID).Observe(duration.Seconds())
 
 	// Record operation status
 	status := "success"
 	if err != nil {
 		status = "error"
 	}
-	h.operationsTotal.WithLabelValues(operation, status).Inc()
+	h.operationsTotal.WithLabelValues(operation, op.AccountID, status).Inc()
 }
 
 // OnRequestStart is called before an HTTP request is sent.
@@ -153,6 +289,12 @@ func (h *Hooks) OnRequestEnd(ctx context.Context, info basecamp.RequestInfo, res
 	}
 	h.httpRequestsTotal.WithLabelValues(httpMethod, statusCode).Inc()
 
+	// Record response body size for requests that got a response
+	if result.StatusCode > 0 {
+		operation, _ := ctx.Value(operationKey{}).(string)
+		h.responseBodyBytes.WithLabelValues(operation, httpMethod).Observe(float64(result.ResponseSize))
+	}
+
 	// Record cache operations for GET requests
 	if httpMethod == "GET" {
 		if result.FromCache {
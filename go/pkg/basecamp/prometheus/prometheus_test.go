@@ -3,6 +3,9 @@ package prometheus
 import (
 	"context"
 	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 	"time"
@@ -33,6 +36,47 @@ func TestNewHooks(t *testing.T) {
 	}
 }
 
+func TestNewHooksWithCache(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	cache := basecamp.NewCache(t.TempDir())
+	NewHooks(reg, WithCache(cache))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == `"e"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"e"`)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte("body"))
+	}))
+	defer server.Close()
+
+	client := basecamp.NewClient(&basecamp.Config{BaseURL: server.URL},
+		&basecamp.StaticTokenProvider{Token: "test-token"}, basecamp.WithCache(cache))
+	for range 2 {
+		if _, err := client.Get(context.Background(), "/key.json"); err != nil {
+			t.Fatalf("Get: %v", err)
+		}
+	}
+
+	expected := `
+		# HELP basecamp_cache_hits_total Total number of cached GET requests answered from the cache.
+		# TYPE basecamp_cache_hits_total counter
+		basecamp_cache_hits_total 1
+		# HELP basecamp_cache_misses_total Total number of cached GET requests not answered from the cache.
+		# TYPE basecamp_cache_misses_total counter
+		basecamp_cache_misses_total 1
+		# HELP basecamp_cache_size_bytes Total size of cached response bodies in bytes.
+		# TYPE basecamp_cache_size_bytes gauge
+		basecamp_cache_size_bytes 4
+	`
+	if err := testutil.GatherAndCompare(reg, strings.NewReader(expected),
+		"basecamp_cache_hits_total", "basecamp_cache_misses_total", "basecamp_cache_size_bytes"); err != nil {
+		t.Error(err)
+	}
+}
+
 func TestOnOperationStartEnd(t *testing.T) {
 	reg := prometheus.NewRegistry()
 	hooks := NewHooks(reg)
@@ -70,6 +114,27 @@ func TestOnOperationStartEnd(t *testing.T) {
 	}
 }
 
+func TestOnOperationEndLabelsAccountID(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	hooks := NewHooks(reg)
+	ctx := context.Background()
+
+	for _, accountID := range []string{"111", "222", "222"} {
+		op := basecamp.OperationInfo{Service: "Todos", Operation: "List", AccountID: accountID}
+		hooks.OnOperationEnd(hooks.OnOperationStart(ctx, op), op, nil, time.Millisecond)
+	}
+
+	expected := `
+		# HELP basecamp_operations_total Total number of Basecamp API operations.
+		# TYPE basecamp_operations_total counter
+		basecamp_operations_total{account_id="111",operation="Todos.List",status="success"} 1
+		basecamp_operations_total{account_id="222",operation="Todos.List",status="success"} 2
+	`
+	if err := testutil.GatherAndCompare(reg, strings.NewReader(expected), "basecamp_operations_total"); err != nil {
+		t.Error(err)
+	}
+}
+
 func TestOnOperationEndWithError(t *testing.T) {
 	reg := prometheus.NewRegistry()
 	hooks := NewHooks(reg)
@@ -271,3 +336,173 @@ func TestMetricLabels(t *testing.T) {
 		t.Errorf("expected at least %d metric series, got %d", len(statusCodes), count)
 	}
 }
+
+// failureCounter is a basecamp.CircuitBreaker that counts reported outcomes.
+type failureCounter struct {
+	successes, failures int
+}
+
+func (c *failureCounter) Success() { c.successes++ }
+func (c *failureCounter) Failure() { c.failures++ }
+
+func TestChainedWithCircuitBreakerHook(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	reg := prometheus.NewRegistry()
+	breaker := &failureCounter{}
+	hooks := basecamp.NewChainHooks(NewHooks(reg), basecamp.NewCircuitBreakerHook(breaker))
+
+	cfg := basecamp.DefaultConfig()
+	cfg.BaseURL = server.URL
+	cfg.CacheEnabled = false
+	client := basecamp.NewClient(cfg, &basecamp.StaticTokenProvider{Token: "test-token"},
+		basecamp.WithHooks(hooks),
+	)
+
+	// Create is a POST, so the 503 is not retried.
+	_, err := client.ForAccount("111").Projects().Create(context.Background(), &basecamp.CreateProjectRequest{Name: "Launch"})
+	if err == nil {
+		t.Fatal("expected error for 503 response")
+	}
+
+	expected := `
+		# HELP basecamp_operations_total Total number of Basecamp API operations.
+		# TYPE basecamp_operations_total counter
+		basecamp_operations_total{account_id="111",operation="Projects.Create",status="error"} 1
+	`
+	if err := testutil.GatherAndCompare(reg, strings.NewReader(expected), "basecamp_operations_total"); err != nil {
+		t.Error(err)
+	}
+	if breaker.failures != 1 {
+		t.Errorf("breaker failures = %d, want 1", breaker.failures)
+	}
+	if breaker.successes != 0 {
+		t.Errorf("breaker successes = %d, want 0", breaker.successes)
+	}
+}
+
+func TestResponseBodyBytes(t *testing.T) {
+	const body = `{"id":1,"name":"Launch"}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	reg := prometheus.NewRegistry()
+	cfg := basecamp.DefaultConfig()
+	cfg.BaseURL = server.URL
+	client := basecamp.NewClient(cfg, &basecamp.StaticTokenProvider{Token: "test-token"},
+		basecamp.WithHooks(NewHooks(reg)),
+	)
+
+	if _, err := client.ForAccount("111").Projects().Create(context.Background(), &basecamp.CreateProjectRequest{Name: "Launch"}); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	expected := fmt.Sprintf(`
+		# HELP basecamp_response_body_bytes Size of Basecamp API response bodies in bytes.
+		# TYPE basecamp_response_body_bytes histogram
+		basecamp_response_body_bytes_bucket{http_method="POST",operation="Projects.Create",le="512"} 1
+		basecamp_response_body_bytes_bucket{http_method="POST",operation="Projects.Create",le="4096"} 1
+		basecamp_response_body_bytes_bucket{http_method="POST",operation="Projects.Create",le="65536"} 1
+		basecamp_response_body_bytes_bucket{http_method="POST",operation="Projects.Create",le="1.048576e+06"} 1
+		basecamp_response_body_bytes_bucket{http_method="POST",operation="Projects.Create",le="1.048576e+07"} 1
+		basecamp_response_body_bytes_bucket{http_method="POST",operation="Projects.Create",le="+Inf"} 1
+		basecamp_response_body_bytes_sum{http_method="POST",operation="Projects.Create"} %d
+		basecamp_response_body_bytes_count{http_method="POST",operation="Projects.Create"} 1
+	`, len(body))
+	if err := testutil.GatherAndCompare(reg, strings.NewReader(expected), "basecamp_response_body_bytes"); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestResponseBodyBytesOutsideOperation(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	hooks := NewHooks(reg)
+	info := basecamp.RequestInfo{Method: "GET", URL: "https://example.com", Attempt: 1}
+
+	ctx := hooks.OnRequestStart(context.Background(), info)
+	hooks.OnRequestEnd(ctx, info, basecamp.RequestResult{StatusCode: 200, ResponseSize: 2048})
+	hooks.OnRequestEnd(ctx, info, basecamp.RequestResult{Error: errors.New("connection refused")})
+
+	if got := testutil.CollectAndCount(reg, "basecamp_response_body_bytes"); got != 1 {
+		t.Errorf("expected 1 response size series, got %d", got)
+	}
+}
+
+func TestNewHooksWithConfigCustomBuckets(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	hooks, err := NewHooksWithConfig(reg, PromConfig{
+		DurationBuckets:     []float64{0.1, 1, 10},
+		ResponseSizeBuckets: []float64{1024, 1048576},
+	})
+	if err != nil {
+		t.Fatalf("NewHooksWithConfig: %v", err)
+	}
+
+	op := basecamp.OperationInfo{Service: "Todos", Operation: "List", AccountID: "111"}
+	ctx := hooks.OnOperationStart(context.Background(), op)
+	hooks.OnRequestEnd(ctx, basecamp.RequestInfo{Method: "GET"}, basecamp.RequestResult{StatusCode: 200, ResponseSize: 2048})
+	hooks.OnOperationEnd(ctx, op, nil, 500*time.Millisecond)
+
+	expected := `
+		# HELP basecamp_operation_duration_seconds Duration of Basecamp API operations in seconds.
+		# TYPE basecamp_operation_duration_seconds histogram
+		basecamp_operation_duration_seconds_bucket{account_id="111",operation="Todos.List",le="0.1"} 0
+		basecamp_operation_duration_seconds_bucket{account_id="111",operation="Todos.List",le="1"} 1
+		basecamp_operation_duration_seconds_bucket{account_id="111",operation="Todos.List",le="10"} 1
+		basecamp_operation_duration_seconds_bucket{account_id="111",operation="Todos.List",le="+Inf"} 1
+		basecamp_operation_duration_seconds_sum{account_id="111",operation="Todos.List"} 0.5
+		basecamp_operation_duration_seconds_count{account_id="111",operation="Todos.List"} 1
+		# HELP basecamp_response_body_bytes Size of Basecamp API response bodies in bytes.
+		# TYPE basecamp_response_body_bytes histogram
+		basecamp_response_body_bytes_bucket{http_method="GET",operation="Todos.List",le="1024"} 0
+		basecamp_response_body_bytes_bucket{http_method="GET",operation="Todos.List",le="1.048576e+06"} 1
+		basecamp_response_body_bytes_bucket{http_method="GET",operation="Todos.List",le="+Inf"} 1
+		basecamp_response_body_bytes_sum{http_method="GET",operation="Todos.List"} 2048
+		basecamp_response_body_bytes_count{http_method="GET",operation="Todos.List"} 1
+	`
+	if err := testutil.GatherAndCompare(reg, strings.NewReader(expected),
+		"basecamp_operation_duration_seconds", "basecamp_response_body_bytes"); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestNewHooksWithConfigInvalidBuckets(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  PromConfig
+	}{
+		{"unsorted durations", PromConfig{DurationBuckets: []float64{1, 0.5}, ResponseSizeBuckets: ResponseSizeBuckets}},
+		{"duplicate durations", PromConfig{DurationBuckets: []float64{1, 1}, ResponseSizeBuckets: ResponseSizeBuckets}},
+		{"empty durations", PromConfig{ResponseSizeBuckets: ResponseSizeBuckets}},
+		{"unsorted sizes", PromConfig{DurationBuckets: prometheus.DefBuckets, ResponseSizeBuckets: []float64{4096, 512}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := prometheus.NewRegistry()
+			hooks, err := NewHooksWithConfig(reg, tt.cfg)
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if hooks != nil {
+				t.Error("expected nil hooks on error")
+			}
+			if families, _ := reg.Gather(); len(families) != 0 {
+				t.Errorf("expected nothing registered, got %d metric families", len(families))
+			}
+		})
+	}
+}
+
+func TestDefaultPromConfigValid(t *testing.T) {
+	if err := DefaultPromConfig().Validate(); err != nil {
+		t.Errorf("DefaultPromConfig().Validate() = %v", err)
+	}
+}
@@ -21,6 +21,12 @@ type RateLimitConfig struct {
 	// Default: true
 	RespectRetryAfter bool
 
+	// Wait makes callers block until a token is available instead of
+	// failing fast with ErrRateLimited. Cancelling the context while
+	// waiting returns the context's error.
+	// Default: false
+	Wait bool
+
 	// Now is a function that returns the current time. Used for testing.
 	// If nil, time.Now is used.
 	Now func() time.Time
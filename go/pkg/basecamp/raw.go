@@ -0,0 +1,126 @@
+package basecamp
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// RawGet performs an account-scoped GET request and returns the raw HTTP
+// response with its body unread. It is an escape hatch for endpoints the SDK
+// does not wrap yet.
+//
+// The request is authenticated and retried on 429, 502, 503 and 504 responses
+// and network errors, like other GET requests. Any other response, including
+// 4xx errors, is returned as-is for the caller to inspect. Responses are never
+// cached. The caller is responsible for closing the returned Body.
+func (ac *AccountClient) RawGet(ctx context.Context, path string) (*http.Response, error) {
+	c := ac.parent
+	url, err := c.buildURL(ac.accountPath(path))
+	if err != nil {
+		return nil, err
+	}
+
+	var lastErr error
+	for attempt := 1; attempt <= c.httpOpts.MaxRetries; attempt++ {
+		req, err := c.newRawRequest(contextWithAttempt(ctx, attempt), "GET", url, nil, "")
+		if err != nil {
+			return nil, err
+		}
+
+		resp, doErr := c.httpClient.Do(req) // #nosec G704 -- SDK HTTP client: URL is caller-configured
+
+		var retryAfter int
+		switch {
+		case doErr != nil:
+			lastErr = ErrNetwork(doErr)
+		case resp.StatusCode == http.StatusTooManyRequests ||
+			resp.StatusCode == http.StatusBadGateway ||
+			resp.StatusCode == http.StatusServiceUnavailable ||
+			resp.StatusCode == http.StatusGatewayTimeout:
+			body, _ := io.ReadAll(io.LimitReader(resp.Body, int64(maxErrorMessageLen*2)))
+			_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, c.maxErrorBodyBytes))
+			_ = resp.Body.Close()
+			lastErr = checkResponse(resp, body)
+			if resp.StatusCode == http.StatusTooManyRequests {
+				retryAfter = parseRetryAfter(resp.Header.Get("Retry-After"))
+			}
+		default:
+			return resp, nil
+		}
+
+		if attempt >= c.httpOpts.MaxRetries {
+			break
+		}
+
+		delay := c.backoffDelay(attempt)
+		if retryAfter > 0 {
+			delay = time.Duration(retryAfter) * time.Second
+		}
+		c.hooks.OnRetry(ctx, RequestInfo{Method: "GET", URL: url, Attempt: attempt}, attempt+1, lastErr)
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+
+	if lastErr == nil {
+		return nil, ErrUsage(fmt.Sprintf("request aborted: MaxRetries (%d) must be >= 1", c.httpOpts.MaxRetries))
+	}
+	return nil, lastErr
+}
+
+// RawPost performs an account-scoped POST request with the given body and
+// content type, and returns the raw HTTP response with its body unread.
+//
+// The request is authenticated but, like other mutations, never retried.
+// Any response status is returned as-is for the caller to inspect. The caller
+// is responsible for closing the returned Body.
+func (ac *AccountClient) RawPost(ctx context.Context, path string, body io.Reader, contentType string) (*http.Response, error) {
+	c := ac.parent
+	url, err := c.buildURL(ac.accountPath(path))
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.newRawRequest(contextWithAttempt(ctx, 1), "POST", url, body, contentType)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(req) // #nosec G704 -- SDK HTTP client: URL is caller-configured
+	if err != nil {
+		return nil, ErrNetwork(err)
+	}
+	return resp, nil
+}
+
+// newRawRequest builds an authenticated request for the raw escape hatches.
+// Unlike singleRequest it sets no Accept header and no cache validators.
+func (c *Client) newRawRequest(ctx context.Context, method, url string, body io.Reader, contentType string) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.assertCredentialOrigin(req); err != nil {
+		return nil, err
+	}
+	if err := c.authStrategy.Authenticate(c.withRequestAccountID(ctx, req), req); err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", c.userAgent)
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if key := idempotencyKeyFromContext(ctx); key != "" {
+		req.Header.Set(idempotencyKeyHeader, key)
+	}
+	if err := c.editRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
@@ -0,0 +1,170 @@
+package basecamp
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func testRawServer(t *testing.T, handler http.HandlerFunc) *AccountClient {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	cfg := DefaultConfig()
+	cfg.BaseURL = server.URL
+	cfg.CacheEnabled = false
+	client := NewClient(cfg, &StaticTokenProvider{Token: "test-token"},
+		WithBaseDelay(time.Millisecond),
+		WithMaxJitter(time.Millisecond),
+	)
+	return client.ForAccount("99999")
+}
+
+func TestAccountClient_RawGet(t *testing.T) {
+	account := testRawServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Method = %s, want GET", r.Method)
+		}
+		if r.URL.Path != "/99999/future/endpoint.json" {
+			t.Errorf("Path = %s, want /99999/future/endpoint.json", r.URL.Path)
+		}
+		if got := r.Header.Get("Authorization"); got != "Bearer test-token" {
+			t.Errorf("Authorization = %q, want %q", got, "Bearer test-token")
+		}
+		if got := r.Header.Get("User-Agent"); got != DefaultUserAgent {
+			t.Errorf("User-Agent = %q, want %q", got, DefaultUserAgent)
+		}
+		if got := r.Header.Get("If-None-Match"); got != "" {
+			t.Errorf("If-None-Match = %q, want empty", got)
+		}
+		w.Header().Set("Content-Type", "text/plain")
+		_, _ = w.Write([]byte("raw body"))
+	})
+
+	resp, err := account.RawGet(context.Background(), "/future/endpoint.json")
+	if err != nil {
+		t.Fatalf("RawGet() error = %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("StatusCode = %d, want 200", resp.StatusCode)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	if string(body) != "raw body" {
+		t.Errorf("body = %q, want %q", body, "raw body")
+	}
+}
+
+func TestAccountClient_RawGet_RetriesThenReturnsErrorStatuses(t *testing.T) {
+	var calls atomic.Int32
+	account := testRawServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error":"nope"}`))
+	})
+
+	resp, err := account.RawGet(context.Background(), "/missing.json")
+	if err != nil {
+		t.Fatalf("RawGet() error = %v", err)
+	}
+	defer resp.Body.Close()
+
+	if calls.Load() != 2 {
+		t.Errorf("calls = %d, want 2 (one retry after 503)", calls.Load())
+	}
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("StatusCode = %d, want 404", resp.StatusCode)
+	}
+}
+
+func TestAccountClient_RawGet_ContextCanceled(t *testing.T) {
+	release := make(chan struct{})
+	account := testRawServer(t, func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	})
+	t.Cleanup(func() { close(release) })
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := account.RawGet(ctx, "/slow.json")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("RawGet() error = %v, want context.DeadlineExceeded", err)
+	}
+}
+
+func TestAccountClient_RawPost(t *testing.T) {
+	var calls atomic.Int32
+	account := testRawServer(t, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		if r.Method != http.MethodPost {
+			t.Errorf("Method = %s, want POST", r.Method)
+		}
+		if r.URL.Path != "/99999/future/upload" {
+			t.Errorf("Path = %s, want /99999/future/upload", r.URL.Path)
+		}
+		if got := r.Header.Get("Authorization"); got != "Bearer test-token" {
+			t.Errorf("Authorization = %q, want %q", got, "Bearer test-token")
+		}
+		if got := r.Header.Get("User-Agent"); got != DefaultUserAgent {
+			t.Errorf("User-Agent = %q, want %q", got, DefaultUserAgent)
+		}
+		if got := r.Header.Get("Content-Type"); got != "text/csv" {
+			t.Errorf("Content-Type = %q, want text/csv", got)
+		}
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != "a,b\n1,2\n" {
+			t.Errorf("body = %q", body)
+		}
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	resp, err := account.RawPost(context.Background(), "future/upload", strings.NewReader("a,b\n1,2\n"), "text/csv")
+	if err != nil {
+		t.Fatalf("RawPost() error = %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("StatusCode = %d, want 503", resp.StatusCode)
+	}
+	if calls.Load() != 1 {
+		t.Errorf("calls = %d, want 1 (mutations are not retried)", calls.Load())
+	}
+}
+
+func TestAccountClient_RawPost_ContextCanceled(t *testing.T) {
+	release := make(chan struct{})
+	account := testRawServer(t, func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	})
+	t.Cleanup(func() { close(release) })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		cancel()
+	}()
+
+	_, err := account.RawPost(ctx, "/slow", strings.NewReader("{}"), "application/json")
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("RawPost() error = %v, want context.Canceled", err)
+	}
+}
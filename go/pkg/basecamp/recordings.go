@@ -119,11 +119,34 @@ func NewRecordingsService(client *AccountClient) *RecordingsService {
 //
 // The returned RecordingListResult includes pagination metadata (TotalCount from
 // X-Total-Count header) when available.
-func (s *RecordingsService) List(ctx context.Context, recordingType RecordingType, opts *RecordingsListOptions) (result *RecordingListResult, err error) {
+func (s *RecordingsService) List(ctx context.Context, recordingType RecordingType, opts *RecordingsListOptions) (*RecordingListResult, error) {
 	op := OperationInfo{
 		Service: "Recordings", Operation: "List",
 		ResourceType: "recording", IsMutation: false,
+		AccountID: s.client.accountID,
 	}
+	return s.list(ctx, op, recordingType, opts)
+}
+
+// ListTrashed returns trashed recordings of a given type across projects.
+// It is equivalent to List with Status set to "trashed"; any Status in opts
+// is ignored. All other options behave as they do for List.
+func (s *RecordingsService) ListTrashed(ctx context.Context, recordingType RecordingType, opts *RecordingsListOptions) (*RecordingListResult, error) {
+	op := OperationInfo{
+		Service: "Recordings", Operation: "ListTrashed",
+		ResourceType: "recording", IsMutation: false,
+		AccountID: s.client.accountID,
+	}
+	trashed := RecordingsListOptions{}
+	if opts != nil {
+		trashed = *opts
+	}
+	trashed.Status = "trashed"
+	return s.list(ctx, op, recordingType, &trashed)
+}
+
+// list implements List and ListTrashed under the given operation info.
+func (s *RecordingsService) list(ctx context.Context, op OperationInfo, recordingType RecordingType, opts *RecordingsListOptions) (result *RecordingListResult, err error) {
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
 			return
@@ -173,6 +196,7 @@ func (s *RecordingsService) List(ctx context.Context, recordingType RecordingTyp
 
 	// Capture total count from X-Total-Count header (first page only)
 	totalCount := parseTotalCount(resp.HTTPResponse)
+	totalPages := parseTotalPages(resp.HTTPResponse, firstPageLen(resp.JSON200))
 
 	// Parse first page
 	var recordings []Recording
@@ -184,7 +208,7 @@ func (s *RecordingsService) List(ctx context.Context, recordingType RecordingTyp
 
 	// Handle single page fetch (--page flag)
 	if opts != nil && opts.Page > 0 {
-		return &RecordingListResult{Recordings: recordings, Meta: ListMeta{TotalCount: totalCount}}, nil
+		return &RecordingListResult{Recordings: recordings, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages}}, nil
 	}
 
 	// Determine limit: 0 = default (100), -1 = unlimited, >0 = specific limit
@@ -199,7 +223,7 @@ func (s *RecordingsService) List(ctx context.Context, recordingType RecordingTyp
 
 	// Check if we already have enough items
 	if limit > 0 && len(recordings) >= limit {
-		return &RecordingListResult{Recordings: recordings[:limit], Meta: ListMeta{TotalCount: totalCount, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(recordings), limit)}}, nil
+		return &RecordingListResult{Recordings: recordings[:limit], Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(recordings), limit)}}, nil
 	}
 
 	// Follow pagination via Link headers (uses absolute URLs from API, no path construction)
@@ -217,7 +241,7 @@ func (s *RecordingsService) List(ctx context.Context, recordingType RecordingTyp
 		recordings = append(recordings, recordingFromGenerated(gr))
 	}
 
-	return &RecordingListResult{Recordings: recordings, Meta: ListMeta{TotalCount: totalCount, Truncated: truncated}}, nil
+	return &RecordingListResult{Recordings: recordings, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: truncated}}, nil
 }
 
 // Get returns a recording by ID.
@@ -226,6 +250,7 @@ func (s *RecordingsService) Get(ctx context.Context, recordingID int64) (result
 		Service: "Recordings", Operation: "Get",
 		ResourceType: "recording", IsMutation: false,
 		ResourceID: recordingID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -259,6 +284,7 @@ func (s *RecordingsService) Trash(ctx context.Context, recordingID int64) (err e
 		Service: "Recordings", Operation: "Trash",
 		ResourceType: "recording", IsMutation: true,
 		ResourceID: recordingID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -283,6 +309,7 @@ func (s *RecordingsService) Archive(ctx context.Context, recordingID int64) (err
 		Service: "Recordings", Operation: "Archive",
 		ResourceType: "recording", IsMutation: true,
 		ResourceID: recordingID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -306,6 +333,34 @@ func (s *RecordingsService) Unarchive(ctx context.Context, recordingID int64) (e
 		Service: "Recordings", Operation: "Unarchive",
 		ResourceType: "recording", IsMutation: true,
 		ResourceID: recordingID,
+		AccountID:  s.client.accountID,
+	}
+	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
+		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
+			return
+		}
+	}
+	start := time.Now()
+	ctx = s.client.parent.hooks.OnOperationStart(ctx, op)
+	defer func() { s.client.parent.hooks.OnOperationEnd(ctx, op, err, time.Since(start)) }()
+
+	resp, err := s.client.parent.gen.UnarchiveRecordingWithResponse(ctx, s.client.accountID, recordingID)
+	if err != nil {
+		return err
+	}
+	return checkResponse(resp.HTTPResponse, resp.Body)
+}
+
+// Restore returns a trashed recording to active status.
+// Basecamp uses the same status endpoint for restoring from the trash and
+// unarchiving, so this is equivalent to Unarchive; it exists so callers
+// undoing a Trash read naturally.
+func (s *RecordingsService) Restore(ctx context.Context, recordingID int64) (err error) {
+	op := OperationInfo{
+		Service: "Recordings", Operation: "Restore",
+		ResourceType: "recording", IsMutation: true,
+		ResourceID: recordingID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -323,6 +378,28 @@ func (s *RecordingsService) Unarchive(ctx context.Context, recordingID int64) (e
 	return checkResponse(resp.HTTPResponse, resp.Body)
 }
 
+// TrashBatch moves many recordings to the trash concurrently, at most
+// opts.Concurrency at a time (DefaultBatchConcurrency by default). Each
+// recording is a Trash, so hooks observe Recordings.Trash per recording.
+//
+// TrashBatch attempts every recording even if some fail. It returns the IDs
+// that were trashed, in ascending order, and a *BulkError listing each
+// failure by recording ID, or nil if none failed.
+func (s *RecordingsService) TrashBatch(ctx context.Context, recordingIDs []int64, opts *BatchOptions) ([]int64, error) {
+	return runBatch(recordingIDs, opts, func(id int64) error {
+		return s.Trash(ctx, id)
+	})
+}
+
+// RestoreBatch returns many trashed recordings to active status concurrently.
+// It behaves like TrashBatch, with hooks observing Recordings.Restore per
+// recording.
+func (s *RecordingsService) RestoreBatch(ctx context.Context, recordingIDs []int64, opts *BatchOptions) ([]int64, error) {
+	return runBatch(recordingIDs, opts, func(id int64) error {
+		return s.Restore(ctx, id)
+	})
+}
+
 // SetClientVisibility sets whether a recording is visible to clients.
 // visible specifies whether the recording should be visible to clients.
 // Returns the updated recording.
@@ -332,6 +409,7 @@ func (s *RecordingsService) SetClientVisibility(ctx context.Context, recordingID
 		Service: "Recordings", Operation: "SetClientVisibility",
 		ResourceType: "recording", IsMutation: true,
 		ResourceID: recordingID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
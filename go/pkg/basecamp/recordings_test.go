@@ -1,10 +1,19 @@
 package basecamp
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 )
 
 func recordingsFixturesDir() string {
@@ -279,3 +288,217 @@ func TestRecordingsListOptions_BuildsQueryParams(t *testing.T) {
 		t.Errorf("expected direction 'asc', got %q", opts.Direction)
 	}
 }
+
+// testRecordingsServer creates an httptest.Server and a RecordingsService wired to it.
+func testRecordingsServer(t *testing.T, handler http.HandlerFunc, opts ...ClientOption) *RecordingsService {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	cfg := DefaultConfig()
+	cfg.BaseURL = server.URL
+	client := NewClient(cfg, &StaticTokenProvider{Token: "test-token"}, opts...)
+	return client.ForAccount("99999").Recordings()
+}
+
+func TestRecordingsService_Restore(t *testing.T) {
+	hooks := &recordingHooks{}
+	svc := testRecordingsServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" {
+			t.Errorf("expected PUT, got %s", r.Method)
+		}
+		if r.URL.Path != "/99999/recordings/42/status/active.json" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		w.WriteHeader(204)
+	}, WithHooks(hooks))
+
+	if err := svc.Restore(context.Background(), 42); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(hooks.opStartCalls) != 1 {
+		t.Fatalf("expected 1 operation start, got %d", len(hooks.opStartCalls))
+	}
+	op := hooks.opStartCalls[0]
+	if op.Operation != "Restore" || !op.IsMutation || op.ResourceID != 42 {
+		t.Errorf("unexpected operation info: %+v", op)
+	}
+}
+
+func TestRecordingsService_Restore_NotFound(t *testing.T) {
+	svc := testRecordingsServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(404)
+	})
+
+	err := svc.Restore(context.Background(), 999)
+	if err == nil {
+		t.Fatal("expected error for 404")
+	}
+	apiErr, ok := errors.AsType[*Error](err)
+	if !ok || apiErr.Code != CodeNotFound {
+		t.Errorf("expected not_found error, got: %v", err)
+	}
+}
+
+func TestRecordingsService_Trash(t *testing.T) {
+	svc := testRecordingsServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" {
+			t.Errorf("expected PUT, got %s", r.Method)
+		}
+		if r.URL.Path != "/99999/recordings/42/status/trashed.json" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		w.WriteHeader(204)
+	})
+
+	if err := svc.Trash(context.Background(), 42); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestRecordingsService_TrashBatch_PartialFailure(t *testing.T) {
+	var mu sync.Mutex
+	var paths []string
+	svc := testRecordingsServer(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		paths = append(paths, r.URL.Path)
+		mu.Unlock()
+		if r.URL.Path == "/99999/recordings/2/status/trashed.json" || r.URL.Path == "/99999/recordings/4/status/trashed.json" {
+			w.WriteHeader(404)
+			return
+		}
+		w.WriteHeader(204)
+	})
+
+	trashed, err := svc.TrashBatch(context.Background(), []int64{5, 4, 3, 2, 1}, nil)
+	if !slices.Equal(trashed, []int64{1, 3, 5}) {
+		t.Errorf("trashed = %v, want [1 3 5]", trashed)
+	}
+	bulk, ok := errors.AsType[*BulkError](err)
+	if !ok {
+		t.Fatalf("expected *BulkError, got %v", err)
+	}
+	if len(bulk.Errors) != 2 || bulk.Errors[0].ResourceID != 2 || bulk.Errors[1].ResourceID != 4 {
+		t.Fatalf("bulk errors = %+v, want failures for 2 and 4", bulk.Errors)
+	}
+	if !IsNotFound(bulk.Errors[0].Err) {
+		t.Errorf("expected not-found error, got %v", bulk.Errors[0].Err)
+	}
+	if len(paths) != 5 {
+		t.Errorf("expected 5 requests, got %d", len(paths))
+	}
+}
+
+func TestRecordingsService_RestoreBatch_LimitsConcurrency(t *testing.T) {
+	var inFlight, peak atomic.Int32
+	svc := testRecordingsServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/status/active.json") {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		n := inFlight.Add(1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		inFlight.Add(-1)
+		w.WriteHeader(204)
+	})
+
+	ids := make([]int64, 12)
+	for i := range ids {
+		ids[i] = int64(i + 1)
+	}
+	restored, err := svc.RestoreBatch(context.Background(), ids, &BatchOptions{Concurrency: 3})
+	if err != nil {
+		t.Fatalf("RestoreBatch() error = %v", err)
+	}
+	if !slices.Equal(restored, ids) {
+		t.Errorf("restored = %v, want %v", restored, ids)
+	}
+	if got := peak.Load(); got > 3 {
+		t.Errorf("peak concurrency = %d, want at most 3", got)
+	}
+}
+
+func TestRecordingsService_SetClientVisibility(t *testing.T) {
+	hooks := &recordingHooks{}
+	fixture := loadRecordingsFixture(t, "client_visibility.json")
+	svc := testRecordingsServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" {
+			t.Errorf("expected PUT, got %s", r.Method)
+		}
+		if r.URL.Path != "/99999/recordings/42/client_visibility.json" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode body: %v", err)
+		}
+		if body["visible_to_clients"] != false {
+			t.Errorf("visible_to_clients = %v, want false", body["visible_to_clients"])
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(fixture)
+	}, WithHooks(hooks))
+
+	if _, err := svc.SetClientVisibility(context.Background(), 42, false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(hooks.opStartCalls) != 1 {
+		t.Fatalf("expected 1 operation start, got %d", len(hooks.opStartCalls))
+	}
+	op := hooks.opStartCalls[0]
+	if op.Operation != "SetClientVisibility" || !op.IsMutation || op.ResourceID != 42 {
+		t.Errorf("unexpected operation info: %+v", op)
+	}
+}
+
+func TestRecordingsService_ListTrashed(t *testing.T) {
+	hooks := &recordingHooks{}
+	fixture := loadRecordingsFixture(t, "list.json")
+	svc := testRecordingsServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			t.Errorf("expected GET, got %s", r.Method)
+		}
+		if r.URL.Path != "/99999/projects/recordings.json" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		q := r.URL.Query()
+		if q.Get("status") != "trashed" {
+			t.Errorf("status = %q, want trashed", q.Get("status"))
+		}
+		if q.Get("type") != "Todo" {
+			t.Errorf("type = %q, want Todo", q.Get("type"))
+		}
+		if q.Get("sort") != "updated_at" {
+			t.Errorf("sort = %q, want updated_at", q.Get("sort"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(fixture)
+	}, WithHooks(hooks))
+
+	opts := &RecordingsListOptions{Status: "active", Sort: "updated_at"}
+	result, err := svc.ListTrashed(context.Background(), RecordingTypeTodo, opts)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Recordings) == 0 {
+		t.Error("expected recordings")
+	}
+	if opts.Status != "active" {
+		t.Errorf("caller's options were modified: Status = %q", opts.Status)
+	}
+
+	if len(hooks.opStartCalls) != 1 {
+		t.Fatalf("expected 1 operation start, got %d", len(hooks.opStartCalls))
+	}
+	op := hooks.opStartCalls[0]
+	if op.Operation != "ListTrashed" || op.IsMutation {
+		t.Errorf("unexpected operation info: %+v", op)
+	}
+}
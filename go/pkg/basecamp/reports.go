@@ -23,6 +23,7 @@ func (s *ReportsService) AssignablePeople(ctx context.Context) (result []Person,
 	op := OperationInfo{
 		Service: "Reports", Operation: "AssignablePeople",
 		ResourceType: "person", IsMutation: false,
+		AccountID: s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -71,6 +72,7 @@ func (s *ReportsService) AssignedTodos(ctx context.Context, personID int64, opts
 		Service: "Reports", Operation: "AssignedTodos",
 		ResourceType: "todo", IsMutation: false,
 		ResourceID: personID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -127,6 +129,7 @@ func (s *ReportsService) OverdueTodos(ctx context.Context) (result *OverdueTodos
 	op := OperationInfo{
 		Service: "Reports", Operation: "OverdueTodos",
 		ResourceType: "todo", IsMutation: false,
+		AccountID: s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -193,6 +196,7 @@ func (s *ReportsService) UpcomingSchedule(ctx context.Context, startDate, endDat
 	op := OperationInfo{
 		Service: "Reports", Operation: "UpcomingSchedule",
 		ResourceType: "schedule_entry", IsMutation: false,
+		AccountID: s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -49,8 +49,13 @@ type resilienceHooks struct {
 	activeReleases  sync.Map // map[uint64]func() - releases keyed by unique ID
 }
 
-// Ensure resilienceHooks implements GatingHooks at compile time.
-var _ GatingHooks = (*resilienceHooks)(nil)
+// Ensure resilienceHooks implements GatingHooks, RequestGatingHooks, and
+// CircuitHooks at compile time.
+var (
+	_ GatingHooks        = (*resilienceHooks)(nil)
+	_ RequestGatingHooks = (*resilienceHooks)(nil)
+	_ CircuitHooks       = (*resilienceHooks)(nil)
+)
 
 // bulkheadPendingKey is the context key for the pending release ID (before OnOperationStart).
 type bulkheadPendingKey struct{}
@@ -130,16 +135,22 @@ func (h *resilienceHooks) OnOperationGate(ctx context.Context, op OperationInfo)
 		ctx = context.WithValue(ctx, bulkheadPendingKey{}, pendingID)
 	}
 
-	// Rate limit (fail fast if no tokens available)
+	// Rate limit (fail fast if no tokens available, unless configured to wait)
 	if h.rateLimiter != nil {
-		if !h.rateLimiter.Allow() {
+		var rateErr error
+		if h.rateLimiter.config.Wait {
+			rateErr = h.rateLimiter.Wait(ctx)
+		} else if !h.rateLimiter.Allow() {
+			rateErr = ErrRateLimited
+		}
+		if rateErr != nil {
 			// Release bulkhead if we acquired one (still in pending)
 			if pendingID, ok := ctx.Value(bulkheadPendingKey{}).(uint64); ok {
 				if release, loaded := h.pendingReleases.LoadAndDelete(pendingID); loaded {
 					release.(func())()
 				}
 			}
-			return ctx, ErrRateLimited
+			return ctx, rateErr
 		}
 	}
 
@@ -183,9 +194,13 @@ func (h *resilienceHooks) OnOperationEnd(ctx context.Context, op OperationInfo,
 	if h.circuitBreakers != nil {
 		cb := h.circuitBreakers.get(scope)
 		if err != nil && shouldTripCircuit(err) {
-			cb.RecordFailure()
+			if cb.RecordFailure() {
+				h.OnCircuitOpen(ctx, op)
+			}
 		} else if err == nil {
-			cb.RecordSuccess()
+			if cb.RecordSuccess() {
+				h.OnCircuitClose(ctx, op)
+			}
 		}
 		// Note: client-side errors (validation, 4xx) neither trip nor heal the circuit
 	}
@@ -224,6 +239,29 @@ func (h *resilienceHooks) OnRetry(ctx context.Context, info RequestInfo, attempt
 	h.inner.OnRetry(ctx, info, attempt, err)
 }
 
+// OnRequestGate delegates to the inner hooks if they implement
+// RequestGatingHooks.
+func (h *resilienceHooks) OnRequestGate(ctx context.Context, info RequestInfo) (context.Context, error) {
+	if gater, ok := h.inner.(RequestGatingHooks); ok {
+		return gater.OnRequestGate(ctx, info)
+	}
+	return ctx, nil
+}
+
+// OnCircuitOpen delegates to the inner hooks if they implement CircuitHooks.
+func (h *resilienceHooks) OnCircuitOpen(ctx context.Context, op OperationInfo) {
+	if ch, ok := h.inner.(CircuitHooks); ok {
+		ch.OnCircuitOpen(ctx, op)
+	}
+}
+
+// OnCircuitClose delegates to the inner hooks if they implement CircuitHooks.
+func (h *resilienceHooks) OnCircuitClose(ctx context.Context, op OperationInfo) {
+	if ch, ok := h.inner.(CircuitHooks); ok {
+		ch.OnCircuitClose(ctx, op)
+	}
+}
+
 // WithResilience enables circuit breaker, bulkhead, and rate limiting.
 // Pass nil to use DefaultResilienceConfig().
 //
@@ -332,6 +370,9 @@ func WithBulkhead(cfg *BulkheadConfig) ClientOption {
 //	        BurstSize:         5,
 //	    }),
 //	)
+//
+// Set Wait to block until a token is available instead of returning
+// ErrRateLimited.
 func WithRateLimit(cfg *RateLimitConfig) ClientOption {
 	return func(c *Client) {
 		if cfg == nil {
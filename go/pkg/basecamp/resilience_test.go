@@ -2,6 +2,9 @@ package basecamp
 
 import (
 	"context"
+	"errors"
+	"net/http"
+	"sync"
 	"testing"
 	"time"
 )
@@ -226,6 +229,79 @@ func TestResilienceHooks_OnOperationGate_RateLimiter(t *testing.T) {
 	})
 }
 
+func TestResilienceHooks_OnOperationGate_RateLimiterWait(t *testing.T) {
+	op := OperationInfo{
+		Service:   "Search",
+		Operation: "Query",
+	}
+
+	t.Run("waits for a token", func(t *testing.T) {
+		rh := &resilienceHooks{
+			inner:       NoopHooks{},
+			rateLimiter: newRateLimiter(&RateLimitConfig{RequestsPerSecond: 20, BurstSize: 1, Wait: true}),
+		}
+
+		_, _ = rh.OnOperationGate(context.Background(), op)
+
+		start := time.Now()
+		_, err := rh.OnOperationGate(context.Background(), op)
+		if err != nil {
+			t.Fatalf("should wait rather than reject: %v", err)
+		}
+		if elapsed := time.Since(start); elapsed < 40*time.Millisecond {
+			t.Errorf("expected to wait for a token, returned after %v", elapsed)
+		}
+	})
+
+	t.Run("returns context error when cancelled", func(t *testing.T) {
+		rh := &resilienceHooks{
+			inner:       NoopHooks{},
+			rateLimiter: newRateLimiter(&RateLimitConfig{RequestsPerSecond: 0.1, BurstSize: 1, Wait: true}),
+		}
+
+		_, _ = rh.OnOperationGate(context.Background(), op)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(20*time.Millisecond, cancel)
+
+		start := time.Now()
+		_, err := rh.OnOperationGate(ctx, op)
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("expected context.Canceled, got %v", err)
+		}
+		if elapsed := time.Since(start); elapsed > time.Second {
+			t.Errorf("cancellation took too long: %v", elapsed)
+		}
+	})
+}
+
+func TestWithRateLimit_Wait_ThrottlesConcurrentRequests(t *testing.T) {
+	svc := testRecordingsServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}, WithRateLimit(&RateLimitConfig{RequestsPerSecond: 10, BurstSize: 10, Wait: true}))
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	errs := make(chan error, 20)
+	for i := range 20 {
+		wg.Go(func() {
+			errs <- svc.Trash(context.Background(), int64(i+1))
+		})
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	// The burst covers the first 10 requests; the remaining 10 need ~1s at 10 rps.
+	if elapsed := time.Since(start); elapsed < 900*time.Millisecond {
+		t.Errorf("expected 20 requests at 10 rps to take about 1s, took %v", elapsed)
+	}
+}
+
 func TestResilienceHooks_OnOperationEnd_UpdatesCircuitBreaker(t *testing.T) {
 	ctx := context.Background()
 	op := OperationInfo{
@@ -611,3 +687,41 @@ func (h *contextReplacingHooks) OnRequestEnd(ctx context.Context, info RequestIn
 
 func (h *contextReplacingHooks) OnRetry(ctx context.Context, info RequestInfo, attempt int, err error) {
 }
+
+func TestResilienceHooks_NotifiesCircuitTransitions(t *testing.T) {
+	ctx := context.Background()
+	op := OperationInfo{Service: "Todos", Operation: "Get"}
+	now := time.Now()
+
+	inner := &circuitHooks{}
+	rh := &resilienceHooks{
+		inner: inner,
+		circuitBreakers: newCircuitBreakerRegistry(&CircuitBreakerConfig{
+			FailureThreshold: 2,
+			SuccessThreshold: 1,
+			OpenTimeout:      time.Minute,
+			Now:              func() time.Time { return now },
+		}),
+	}
+
+	serverErr := ErrAPI(503, "service unavailable")
+	rh.OnOperationEnd(ctx, op, serverErr, time.Second)
+	if len(inner.opened) != 0 {
+		t.Fatalf("circuit opened after 1 failure, threshold is 2")
+	}
+	rh.OnOperationEnd(ctx, op, serverErr, time.Second)
+	rh.OnOperationEnd(ctx, op, serverErr, time.Second) // already open: no second notification
+	if len(inner.opened) != 1 || inner.opened[0] != op {
+		t.Fatalf("opened = %v, want [%v]", inner.opened, op)
+	}
+
+	// Move past the open timeout so the next gate goes half-open, then heal.
+	now = now.Add(2 * time.Minute)
+	if _, err := rh.OnOperationGate(ctx, op); err != nil {
+		t.Fatalf("OnOperationGate() error = %v", err)
+	}
+	rh.OnOperationEnd(ctx, op, nil, time.Second)
+	if len(inner.closed) != 1 || inner.closed[0] != op {
+		t.Errorf("closed = %v, want [%v]", inner.closed, op)
+	}
+}
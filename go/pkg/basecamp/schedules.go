@@ -24,6 +24,13 @@ type ScheduleEntryListOptions struct {
 	// Status filters entries by status: "active", "archived", or "trashed".
 	// If empty, returns active entries (API default).
 	Status string
+
+	// From and To, if set, keep only entries that overlap the range: entries
+	// ending before From or starting after To are dropped. The API has no
+	// date-range parameters, so the range is applied to the fetched entries:
+	// every page is fetched and Limit caps the filtered result.
+	From *time.Time
+	To   *time.Time
 }
 
 // Schedule represents a Basecamp schedule (calendar) within a project.
@@ -155,6 +162,7 @@ func (s *SchedulesService) Get(ctx context.Context, scheduleID int64) (result *S
 		Service: "Schedules", Operation: "Get",
 		ResourceType: "schedule", IsMutation: false,
 		ResourceID: scheduleID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -189,6 +197,9 @@ func (s *SchedulesService) Get(ctx context.Context, scheduleID int64) (result *S
 //   - Limit: maximum number of entries to return (0 = all)
 //   - Page: if non-zero, disables pagination and returns first page only
 //
+// From and To filter entries by date after they are fetched; see
+// ScheduleEntryListOptions.
+//
 // The returned ScheduleEntryListResult includes pagination metadata (TotalCount from
 // X-Total-Count header) when available.
 func (s *SchedulesService) ListEntries(ctx context.Context, scheduleID int64, opts *ScheduleEntryListOptions) (result *ScheduleEntryListResult, err error) {
@@ -196,6 +207,7 @@ func (s *SchedulesService) ListEntries(ctx context.Context, scheduleID int64, op
 		Service: "Schedules", Operation: "ListEntries",
 		ResourceType: "schedule_entry", IsMutation: false,
 		ResourceID: scheduleID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -206,6 +218,12 @@ func (s *SchedulesService) ListEntries(ctx context.Context, scheduleID int64, op
 	ctx = s.client.parent.hooks.OnOperationStart(ctx, op)
 	defer func() { s.client.parent.hooks.OnOperationEnd(ctx, op, err, time.Since(start)) }()
 
+	ranged := opts != nil && (opts.From != nil || opts.To != nil)
+	if ranged && opts.From != nil && opts.To != nil && opts.To.Before(*opts.From) {
+		err = ErrUsage("schedule entry range To must not be before From")
+		return nil, err
+	}
+
 	// Build params for generated client
 	var params *generated.ListScheduleEntriesParams
 	if opts != nil && opts.Status != "" {
@@ -225,6 +243,7 @@ func (s *SchedulesService) ListEntries(ctx context.Context, scheduleID int64, op
 
 	// Capture total count from X-Total-Count header
 	totalCount := parseTotalCount(resp.HTTPResponse)
+	totalPages := parseTotalPages(resp.HTTPResponse, firstPageLen(resp.JSON200))
 
 	// Parse first page
 	var entries []ScheduleEntry
@@ -236,7 +255,10 @@ func (s *SchedulesService) ListEntries(ctx context.Context, scheduleID int64, op
 
 	// Handle single page fetch (--page flag)
 	if opts != nil && opts.Page > 0 {
-		return &ScheduleEntryListResult{Entries: entries, Meta: ListMeta{TotalCount: totalCount}}, nil
+		if ranged {
+			entries = entriesInRange(entries, opts.From, opts.To)
+		}
+		return &ScheduleEntryListResult{Entries: entries, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages}}, nil
 	}
 
 	// Determine limit: 0 = all (default for entries), >0 = specific limit
@@ -245,9 +267,30 @@ func (s *SchedulesService) ListEntries(ctx context.Context, scheduleID int64, op
 		limit = opts.Limit
 	}
 
+	// A date range is applied after fetching, so fetch every page and cap
+	// the filtered entries instead.
+	if ranged {
+		rawMore, truncated, err := s.client.parent.followPagination(ctx, resp.HTTPResponse, len(entries), 0)
+		if err != nil {
+			return nil, err
+		}
+		for _, raw := range rawMore {
+			var ge generated.ScheduleEntry
+			if err := json.Unmarshal(raw, &ge); err != nil {
+				return nil, fmt.Errorf("failed to parse schedule entry: %w", err)
+			}
+			entries = append(entries, scheduleEntryFromGenerated(ge))
+		}
+		entries = entriesInRange(entries, opts.From, opts.To)
+		if limit > 0 && len(entries) > limit {
+			entries, truncated = entries[:limit], true
+		}
+		return &ScheduleEntryListResult{Entries: entries, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: truncated}}, nil
+	}
+
 	// Check if we already have enough items
 	if limit > 0 && len(entries) >= limit {
-		return &ScheduleEntryListResult{Entries: entries[:limit], Meta: ListMeta{TotalCount: totalCount, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(entries), limit)}}, nil
+		return &ScheduleEntryListResult{Entries: entries[:limit], Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(entries), limit)}}, nil
 	}
 
 	// Follow pagination via Link headers (uses absolute URLs from API, no path construction)
@@ -265,7 +308,27 @@ func (s *SchedulesService) ListEntries(ctx context.Context, scheduleID int64, op
 		entries = append(entries, scheduleEntryFromGenerated(ge))
 	}
 
-	return &ScheduleEntryListResult{Entries: entries, Meta: ListMeta{TotalCount: totalCount, Truncated: truncated}}, nil
+	return &ScheduleEntryListResult{Entries: entries, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: truncated}}, nil
+}
+
+// entriesInRange returns the entries that overlap [from, to]. A nil bound is
+// open. An entry without an end time is treated as ending when it starts.
+func entriesInRange(entries []ScheduleEntry, from, to *time.Time) []ScheduleEntry {
+	var kept []ScheduleEntry
+	for _, e := range entries {
+		end := e.EndsAt.Time
+		if end.IsZero() {
+			end = e.StartsAt.Time
+		}
+		if from != nil && end.Before(*from) {
+			continue
+		}
+		if to != nil && e.StartsAt.After(*to) {
+			continue
+		}
+		kept = append(kept, e)
+	}
+	return kept
 }
 
 // GetEntry returns a schedule entry by ID.
@@ -274,6 +337,7 @@ func (s *SchedulesService) GetEntry(ctx context.Context, entryID int64) (result
 		Service: "Schedules", Operation: "GetEntry",
 		ResourceType: "schedule_entry", IsMutation: false,
 		ResourceID: entryID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -307,6 +371,7 @@ func (s *SchedulesService) CreateEntry(ctx context.Context, scheduleID int64, re
 		Service: "Schedules", Operation: "CreateEntry",
 		ResourceType: "schedule_entry", IsMutation: true,
 		ResourceID: scheduleID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -377,6 +442,7 @@ func (s *SchedulesService) UpdateEntry(ctx context.Context, entryID int64, req *
 		Service: "Schedules", Operation: "UpdateEntry",
 		ResourceType: "schedule_entry", IsMutation: true,
 		ResourceID: entryID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -449,6 +515,7 @@ func (s *SchedulesService) GetEntryOccurrence(ctx context.Context, entryID int64
 		Service: "Schedules", Operation: "GetEntryOccurrence",
 		ResourceType: "schedule_entry", IsMutation: false,
 		ResourceID: entryID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -487,6 +554,7 @@ func (s *SchedulesService) UpdateSettings(ctx context.Context, scheduleID int64,
 		Service: "Schedules", Operation: "UpdateSettings",
 		ResourceType: "schedule", IsMutation: true,
 		ResourceID: scheduleID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -529,6 +597,7 @@ func (s *SchedulesService) TrashEntry(ctx context.Context, entryID int64) (err e
 		Service: "Schedules", Operation: "TrashEntry",
 		ResourceType: "schedule_entry", IsMutation: true,
 		ResourceID: entryID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
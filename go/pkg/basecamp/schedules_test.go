@@ -3,11 +3,14 @@ package basecamp
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"slices"
 	"testing"
+	"time"
 )
 
 func schedulesFixturesDir() string {
@@ -605,7 +608,7 @@ func TestUpdateScheduleSettingsRequest_Marshal(t *testing.T) {
 }
 
 // testSchedulesServer creates an httptest.Server and a SchedulesService wired to it.
-func testSchedulesServer(t *testing.T, handler http.HandlerFunc) *SchedulesService {
+func testSchedulesServer(t *testing.T, handler http.HandlerFunc, opts ...ClientOption) *SchedulesService {
 	t.Helper()
 	server := httptest.NewServer(handler)
 	t.Cleanup(server.Close)
@@ -613,7 +616,7 @@ func testSchedulesServer(t *testing.T, handler http.HandlerFunc) *SchedulesServi
 	cfg := DefaultConfig()
 	cfg.BaseURL = server.URL
 	token := &StaticTokenProvider{Token: "test-token"}
-	client := NewClient(cfg, token)
+	client := NewClient(cfg, token, opts...)
 	account := client.ForAccount("99999")
 	return account.Schedules()
 }
@@ -735,3 +738,96 @@ func TestSchedulesService_CreateEntryPartial(t *testing.T) {
 		t.Errorf("expected notify to be omitted when not set, but it was present: %v", receivedBody["notify"])
 	}
 }
+
+func TestSchedulesService_ListEntries_DateRange(t *testing.T) {
+	page1 := `[
+		{"id":1,"summary":"Before","starts_at":"2024-01-01T09:00:00Z","ends_at":"2024-01-01T10:00:00Z"},
+		{"id":2,"summary":"Overlaps start","starts_at":"2024-01-31T22:00:00Z","ends_at":"2024-02-01T02:00:00Z"}
+	]`
+	page2 := `[
+		{"id":3,"summary":"All day","starts_at":"2024-02-10","ends_at":"2024-02-10","all_day":true},
+		{"id":4,"summary":"After","starts_at":"2024-03-01T09:00:00Z","ends_at":"2024-03-01T10:00:00Z"}
+	]`
+
+	var queries []string
+	svc := testSchedulesServer(t, func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("page") == "2" {
+			w.Write([]byte(page2))
+			return
+		}
+		w.Header().Set("Link", fmt.Sprintf(`<http://%s/99999/schedules/1/entries.json?page=2>; rel="next"`, r.Host))
+		w.Write([]byte(page1))
+	})
+
+	from := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 2, 29, 23, 59, 59, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		opts          *ScheduleEntryListOptions
+		wantIDs       []int64
+		wantTruncated bool
+	}{
+		{"from and to", &ScheduleEntryListOptions{From: &from, To: &to}, []int64{2, 3}, false},
+		{"from only", &ScheduleEntryListOptions{From: &from}, []int64{2, 3, 4}, false},
+		{"to only", &ScheduleEntryListOptions{To: &to}, []int64{1, 2, 3}, false},
+		{"limit caps filtered entries", &ScheduleEntryListOptions{From: &from, Limit: 1}, []int64{2}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			queries = nil
+			result, err := svc.ListEntries(context.Background(), 1, tt.opts)
+			if err != nil {
+				t.Fatalf("ListEntries() error = %v", err)
+			}
+			var ids []int64
+			for _, e := range result.Entries {
+				ids = append(ids, e.ID)
+			}
+			if !slices.Equal(ids, tt.wantIDs) {
+				t.Errorf("entry IDs = %v, want %v", ids, tt.wantIDs)
+			}
+			if result.Meta.Truncated != tt.wantTruncated {
+				t.Errorf("Truncated = %v, want %v", result.Meta.Truncated, tt.wantTruncated)
+			}
+			if len(queries) != 2 || queries[0] != "" {
+				t.Errorf("queries = %q, want both pages with no range parameters", queries)
+			}
+		})
+	}
+}
+
+func TestSchedulesService_ListEntries_DateRangeMaxPages(t *testing.T) {
+	svc := testSchedulesServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Link", fmt.Sprintf(`<http://%s/99999/schedules/1/entries.json?page=2>; rel="next"`, r.Host))
+		w.Write([]byte(`[{"id":1,"summary":"In range","starts_at":"2024-02-01T09:00:00Z","ends_at":"2024-02-01T10:00:00Z"}]`))
+	}, WithMaxPages(1))
+
+	from := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	result, err := svc.ListEntries(context.Background(), 1, &ScheduleEntryListOptions{From: &from})
+	if err != nil {
+		t.Fatalf("ListEntries() error = %v", err)
+	}
+	if len(result.Entries) != 1 {
+		t.Errorf("expected 1 entry, got %d", len(result.Entries))
+	}
+	if !result.Meta.Truncated {
+		t.Error("expected Truncated when MaxPages stops pagination")
+	}
+}
+
+func TestSchedulesService_ListEntries_InvalidRange(t *testing.T) {
+	svc := testSchedulesServer(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("expected no request for an inverted range")
+	})
+
+	from := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(-time.Hour)
+	_, err := svc.ListEntries(context.Background(), 1, &ScheduleEntryListOptions{From: &from, To: &to})
+	if ErrorCode(err) != CodeUsage {
+		t.Errorf("expected usage error, got %v", err)
+	}
+}
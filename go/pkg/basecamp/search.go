@@ -141,6 +141,7 @@ func (s *SearchService) Search(ctx context.Context, query string, opts *SearchOp
 	op := OperationInfo{
 		Service: "Search", Operation: "Search",
 		ResourceType: "search", IsMutation: false,
+		AccountID: s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -207,6 +208,7 @@ func (s *SearchService) Search(ctx context.Context, query string, opts *SearchOp
 
 	// Capture total count from X-Total-Count header (first page only)
 	totalCount := parseTotalCount(resp.HTTPResponse)
+	totalPages := parseTotalPages(resp.HTTPResponse, firstPageLen(resp.JSON200))
 
 	// Parse first page
 	var searchResults []SearchResult
@@ -218,7 +220,7 @@ func (s *SearchService) Search(ctx context.Context, query string, opts *SearchOp
 
 	// Handle single page fetch (--page flag)
 	if opts != nil && opts.Page > 0 {
-		return &SearchListResult{Results: searchResults, Meta: ListMeta{TotalCount: totalCount}}, nil
+		return &SearchListResult{Results: searchResults, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages}}, nil
 	}
 
 	// Determine limit: 0 = all (default for search)
@@ -229,7 +231,7 @@ func (s *SearchService) Search(ctx context.Context, query string, opts *SearchOp
 
 	// Check if we already have enough items
 	if limit > 0 && len(searchResults) >= limit {
-		return &SearchListResult{Results: searchResults[:limit], Meta: ListMeta{TotalCount: totalCount, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(searchResults), limit)}}, nil
+		return &SearchListResult{Results: searchResults[:limit], Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(searchResults), limit)}}, nil
 	}
 
 	// Follow pagination via Link headers (uses absolute URLs from API, no path construction)
@@ -247,7 +249,7 @@ func (s *SearchService) Search(ctx context.Context, query string, opts *SearchOp
 		searchResults = append(searchResults, searchResultFromGenerated(gsr))
 	}
 
-	return &SearchListResult{Results: searchResults, Meta: ListMeta{TotalCount: totalCount, Truncated: truncated}}, nil
+	return &SearchListResult{Results: searchResults, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: truncated}}, nil
 }
 
 // Metadata returns the available search filter options: the selectable
@@ -256,6 +258,7 @@ func (s *SearchService) Metadata(ctx context.Context) (result *SearchMetadata, e
 	op := OperationInfo{
 		Service: "Search", Operation: "Metadata",
 		ResourceType: "search", IsMutation: false,
+		AccountID: s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -0,0 +1,142 @@
+// Package slog provides structured logging integration for the Basecamp SDK.
+//
+// It implements the basecamp.Hooks interface on top of log/slog for teams
+// that rely on structured logs rather than distributed tracing. Semantic
+// operations are logged at Info; individual HTTP requests and retries are
+// logged at Debug.
+//
+// # Usage
+//
+//	import (
+//	    "log/slog"
+//
+//	    "github.com/basecamp/basecamp-sdk/go/pkg/basecamp"
+//	    basecampslog "github.com/basecamp/basecamp-sdk/go/pkg/basecamp/slog"
+//	)
+//
+//	hooks := basecampslog.NewHooks(slog.Default(),
+//	    basecampslog.WithErrorLevel(slog.LevelError))
+//	client := basecamp.NewClient(cfg, tokenProvider, basecamp.WithHooks(hooks))
+package slog
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/basecamp/basecamp-sdk/go/pkg/basecamp"
+)
+
+// Hooks implements basecamp.Hooks by writing records to a *slog.Logger.
+type Hooks struct {
+	logger     *slog.Logger
+	level      slog.Level
+	errorLevel slog.Level
+}
+
+// Ensure Hooks implements basecamp.Hooks at compile time.
+var _ basecamp.Hooks = (*Hooks)(nil)
+
+// SlogHookOption configures Hooks.
+type SlogHookOption func(*Hooks)
+
+// WithLevel sets the level for operation start and successful completion
+// records. Default is slog.LevelInfo.
+func WithLevel(level slog.Level) SlogHookOption {
+	return func(h *Hooks) {
+		h.level = level
+	}
+}
+
+// WithErrorLevel sets the level for failed operation records.
+// Default is slog.LevelInfo.
+func WithErrorLevel(level slog.Level) SlogHookOption {
+	return func(h *Hooks) {
+		h.errorLevel = level
+	}
+}
+
+// NewHooks creates Hooks that log to the given logger.
+// If logger is nil, uses slog.Default().
+func NewHooks(logger *slog.Logger, opts ...SlogHookOption) *Hooks {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	h := &Hooks{
+		logger:     logger,
+		level:      slog.LevelInfo,
+		errorLevel: slog.LevelInfo,
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+// OnOperationStart logs the start of a semantic SDK operation.
+func (h *Hooks) OnOperationStart(ctx context.Context, op basecamp.OperationInfo) context.Context {
+	h.logger.LogAttrs(ctx, h.level, "basecamp operation start", operationAttrs(op)...)
+	return ctx
+}
+
+// OnOperationEnd logs the completion of a semantic SDK operation with its
+// duration, status ("success" or "error") and, on failure, the error.
+func (h *Hooks) OnOperationEnd(ctx context.Context, op basecamp.OperationInfo, err error, duration time.Duration) {
+	attrs := append(operationAttrs(op), slog.Duration("duration", duration))
+
+	if err != nil {
+		attrs = append(attrs, slog.String("status", "error"), slog.Any("error", err))
+		h.logger.LogAttrs(ctx, h.errorLevel, "basecamp operation failed", attrs...)
+		return
+	}
+	attrs = append(attrs, slog.String("status", "success"))
+	h.logger.LogAttrs(ctx, h.level, "basecamp operation complete", attrs...)
+}
+
+// OnRequestStart logs the start of an HTTP request at Debug.
+func (h *Hooks) OnRequestStart(ctx context.Context, info basecamp.RequestInfo) context.Context {
+	h.logger.LogAttrs(ctx, slog.LevelDebug, "basecamp request start",
+		slog.String("method", info.Method),
+		slog.String("url", info.URL),
+		slog.Int("attempt", info.Attempt),
+	)
+	return ctx
+}
+
+// OnRequestEnd logs the completion of an HTTP request at Debug.
+func (h *Hooks) OnRequestEnd(ctx context.Context, info basecamp.RequestInfo, result basecamp.RequestResult) {
+	attrs := []slog.Attr{
+		slog.String("method", info.Method),
+		slog.String("url", info.URL),
+		slog.Int("status_code", result.StatusCode),
+		slog.Duration("latency", result.Duration),
+		slog.Bool("from_cache", result.FromCache),
+	}
+
+	if result.Error != nil {
+		attrs = append(attrs, slog.Any("error", result.Error), slog.Bool("retryable", result.Retryable))
+		h.logger.LogAttrs(ctx, slog.LevelDebug, "basecamp request failed", attrs...)
+		return
+	}
+	h.logger.LogAttrs(ctx, slog.LevelDebug, "basecamp request complete", attrs...)
+}
+
+// OnRetry logs a retry attempt at Debug.
+func (h *Hooks) OnRetry(ctx context.Context, info basecamp.RequestInfo, attempt int, err error) {
+	h.logger.LogAttrs(ctx, slog.LevelDebug, "basecamp request retry",
+		slog.String("method", info.Method),
+		slog.String("url", info.URL),
+		slog.Int("attempt", attempt),
+		slog.Any("error", err),
+	)
+}
+
+func operationAttrs(op basecamp.OperationInfo) []slog.Attr {
+	return []slog.Attr{
+		slog.String("service", op.Service),
+		slog.String("operation", op.Operation),
+		slog.String("resource_type", op.ResourceType),
+		slog.Bool("is_mutation", op.IsMutation),
+		slog.String("account_id", op.AccountID),
+	}
+}
@@ -0,0 +1,249 @@
+package slog
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/basecamp/basecamp-sdk/go/pkg/basecamp"
+)
+
+// captureHandler is a slog.Handler that records every record it receives.
+type captureHandler struct {
+	mu      sync.Mutex
+	records []slog.Record
+}
+
+func (h *captureHandler) Enabled(context.Context, slog.Level) bool { return true }
+
+func (h *captureHandler) Handle(_ context.Context, r slog.Record) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.records = append(h.records, r)
+	return nil
+}
+
+func (h *captureHandler) WithAttrs([]slog.Attr) slog.Handler { return h }
+func (h *captureHandler) WithGroup(string) slog.Handler      { return h }
+
+func (h *captureHandler) find(msg string) (slog.Record, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, r := range h.records {
+		if r.Message == msg {
+			return r, true
+		}
+	}
+	return slog.Record{}, false
+}
+
+func attrs(r slog.Record) map[string]slog.Value {
+	m := make(map[string]slog.Value)
+	r.Attrs(func(a slog.Attr) bool {
+		m[a.Key] = a.Value
+		return true
+	})
+	return m
+}
+
+func newCapture(opts ...SlogHookOption) (*Hooks, *captureHandler) {
+	h := &captureHandler{}
+	return NewHooks(slog.New(h), opts...), h
+}
+
+var testOp = basecamp.OperationInfo{
+	Service:      "Todos",
+	Operation:    "Complete",
+	ResourceType: "todo",
+	IsMutation:   true,
+	ResourceID:   789,
+	AccountID:    "123",
+}
+
+func TestHooksImplementsInterface(t *testing.T) {
+	var _ basecamp.Hooks = (*Hooks)(nil)
+}
+
+func TestNewHooksNilLogger(t *testing.T) {
+	if hooks := NewHooks(nil); hooks.logger == nil {
+		t.Error("NewHooks(nil) should fall back to slog.Default()")
+	}
+}
+
+func TestOperationSuccess(t *testing.T) {
+	hooks, h := newCapture()
+	ctx := hooks.OnOperationStart(context.Background(), testOp)
+	hooks.OnOperationEnd(ctx, testOp, nil, 150*time.Millisecond)
+
+	start, ok := h.find("basecamp operation start")
+	if !ok {
+		t.Fatal("missing operation start record")
+	}
+	if start.Level != slog.LevelInfo {
+		t.Errorf("start level = %v, want INFO", start.Level)
+	}
+	if got := attrs(start)["operation"].String(); got != "Complete" {
+		t.Errorf("operation = %q, want Complete", got)
+	}
+
+	end, ok := h.find("basecamp operation complete")
+	if !ok {
+		t.Fatal("missing operation complete record")
+	}
+	if end.Level != slog.LevelInfo {
+		t.Errorf("end level = %v, want INFO", end.Level)
+	}
+	a := attrs(end)
+	if got := a["service"].String(); got != "Todos" {
+		t.Errorf("service = %q, want Todos", got)
+	}
+	if got := a["account_id"].String(); got != "123" {
+		t.Errorf("account_id = %q, want 123", got)
+	}
+	if got := a["duration"].Duration(); got != 150*time.Millisecond {
+		t.Errorf("duration = %v, want 150ms", got)
+	}
+	if got := a["status"].String(); got != "success" {
+		t.Errorf("status = %q, want success", got)
+	}
+	if _, ok := a["error"]; ok {
+		t.Error("successful operation should not log an error attribute")
+	}
+}
+
+func TestOperationError(t *testing.T) {
+	hooks, h := newCapture(WithErrorLevel(slog.LevelError))
+	opErr := errors.New("boom")
+	hooks.OnOperationEnd(context.Background(), testOp, opErr, 20*time.Millisecond)
+
+	end, ok := h.find("basecamp operation failed")
+	if !ok {
+		t.Fatal("missing operation failed record")
+	}
+	if end.Level != slog.LevelError {
+		t.Errorf("level = %v, want ERROR", end.Level)
+	}
+	a := attrs(end)
+	if got := a["status"].String(); got != "error" {
+		t.Errorf("status = %q, want error", got)
+	}
+	if got, _ := a["error"].Any().(error); !errors.Is(got, opErr) {
+		t.Errorf("error = %v, want %v", a["error"], opErr)
+	}
+	if got := a["duration"].Duration(); got != 20*time.Millisecond {
+		t.Errorf("duration = %v, want 20ms", got)
+	}
+}
+
+func TestWithLevel(t *testing.T) {
+	hooks, h := newCapture(WithLevel(slog.LevelWarn))
+	hooks.OnOperationStart(context.Background(), testOp)
+	hooks.OnOperationEnd(context.Background(), testOp, nil, time.Millisecond)
+	hooks.OnOperationEnd(context.Background(), testOp, errors.New("x"), time.Millisecond)
+
+	for msg, want := range map[string]slog.Level{
+		"basecamp operation start":    slog.LevelWarn,
+		"basecamp operation complete": slog.LevelWarn,
+		"basecamp operation failed":   slog.LevelInfo,
+	} {
+		r, ok := h.find(msg)
+		if !ok {
+			t.Errorf("missing %q record", msg)
+			continue
+		}
+		if r.Level != want {
+			t.Errorf("%q level = %v, want %v", msg, r.Level, want)
+		}
+	}
+}
+
+func TestRequestRecords(t *testing.T) {
+	hooks, h := newCapture()
+	info := basecamp.RequestInfo{Method: "GET", URL: "https://3.basecampapi.com/123/projects.json", Attempt: 1}
+
+	ctx := hooks.OnRequestStart(context.Background(), info)
+	hooks.OnRequestEnd(ctx, info, basecamp.RequestResult{StatusCode: 200, Duration: 42 * time.Millisecond})
+	hooks.OnRequestEnd(ctx, info, basecamp.RequestResult{StatusCode: 503, Duration: time.Millisecond, Error: errors.New("unavailable"), Retryable: true})
+	hooks.OnRetry(ctx, info, 2, errors.New("unavailable"))
+
+	start, ok := h.find("basecamp request start")
+	if !ok {
+		t.Fatal("missing request start record")
+	}
+	if start.Level != slog.LevelDebug {
+		t.Errorf("start level = %v, want DEBUG", start.Level)
+	}
+
+	end, ok := h.find("basecamp request complete")
+	if !ok {
+		t.Fatal("missing request complete record")
+	}
+	if end.Level != slog.LevelDebug {
+		t.Errorf("end level = %v, want DEBUG", end.Level)
+	}
+	a := attrs(end)
+	if got := a["method"].String(); got != "GET" {
+		t.Errorf("method = %q, want GET", got)
+	}
+	if got := a["url"].String(); got != info.URL {
+		t.Errorf("url = %q, want %q", got, info.URL)
+	}
+	if got := a["status_code"].Int64(); got != 200 {
+		t.Errorf("status_code = %d, want 200", got)
+	}
+	if got := a["latency"].Duration(); got != 42*time.Millisecond {
+		t.Errorf("latency = %v, want 42ms", got)
+	}
+
+	failed, ok := h.find("basecamp request failed")
+	if !ok {
+		t.Fatal("missing request failed record")
+	}
+	if got := attrs(failed)["retryable"].Bool(); !got {
+		t.Error("retryable = false, want true")
+	}
+
+	if _, ok := h.find("basecamp request retry"); !ok {
+		t.Error("missing request retry record")
+	}
+}
+
+func TestHooksWithClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	hooks, h := newCapture()
+	client := basecamp.NewClient(&basecamp.Config{BaseURL: server.URL},
+		&basecamp.StaticTokenProvider{Token: "test-token"}, basecamp.WithHooks(hooks))
+
+	if err := client.ForAccount("123").Todos().Complete(context.Background(), 789); err != nil {
+		t.Fatalf("Complete() error = %v", err)
+	}
+
+	end, ok := h.find("basecamp operation complete")
+	if !ok {
+		t.Fatal("missing operation complete record")
+	}
+	a := attrs(end)
+	if got := a["operation"].String(); got != "Complete" {
+		t.Errorf("operation = %q, want Complete", got)
+	}
+	if got := a["account_id"].String(); got != "123" {
+		t.Errorf("account_id = %q, want 123", got)
+	}
+
+	req, ok := h.find("basecamp request complete")
+	if !ok {
+		t.Fatal("missing request complete record")
+	}
+	if got := attrs(req)["status_code"].Int64(); got != http.StatusNoContent {
+		t.Errorf("status_code = %d, want 204", got)
+	}
+}
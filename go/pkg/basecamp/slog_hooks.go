@@ -50,6 +50,7 @@ func (h *SlogHooks) OnOperationStart(ctx context.Context, op OperationInfo) cont
 		slog.String("operation", op.Operation),
 		slog.String("resource_type", op.ResourceType),
 		slog.Bool("is_mutation", op.IsMutation),
+		slog.String("account_id", op.AccountID),
 	)
 	return ctx
 }
@@ -59,6 +60,7 @@ func (h *SlogHooks) OnOperationEnd(ctx context.Context, op OperationInfo, err er
 	attrs := []slog.Attr{
 		slog.String("service", op.Service),
 		slog.String("operation", op.Operation),
+		slog.String("account_id", op.AccountID),
 		slog.Duration("duration", duration),
 	}
 
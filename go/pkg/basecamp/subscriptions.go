@@ -40,6 +40,7 @@ func (s *SubscriptionsService) Get(ctx context.Context, recordingID int64) (resu
 		Service: "Subscriptions", Operation: "Get",
 		ResourceType: "subscription", IsMutation: false,
 		ResourceID: recordingID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -73,6 +74,7 @@ func (s *SubscriptionsService) Subscribe(ctx context.Context, recordingID int64)
 		Service: "Subscriptions", Operation: "Subscribe",
 		ResourceType: "subscription", IsMutation: true,
 		ResourceID: recordingID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -106,6 +108,7 @@ func (s *SubscriptionsService) Unsubscribe(ctx context.Context, recordingID int6
 		Service: "Subscriptions", Operation: "Unsubscribe",
 		ResourceType: "subscription", IsMutation: true,
 		ResourceID: recordingID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -130,6 +133,7 @@ func (s *SubscriptionsService) Update(ctx context.Context, recordingID int64, re
 		Service: "Subscriptions", Operation: "Update",
 		ResourceType: "subscription", IsMutation: true,
 		ResourceID: recordingID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -169,6 +173,45 @@ func (s *SubscriptionsService) Update(ctx context.Context, recordingID int64, re
 	return &subscription, nil
 }
 
+// ListSubscribers returns the people subscribed to a recording. The result is
+// never nil; a recording without subscribers yields an empty slice.
+//
+// ListSubscribers is a convenience for Get; hooks observe the Get operation.
+func (s *SubscriptionsService) ListSubscribers(ctx context.Context, recordingID int64) ([]Person, error) {
+	subscription, err := s.Get(ctx, recordingID)
+	if err != nil {
+		return nil, err
+	}
+	if subscription.Subscribers == nil {
+		return []Person{}, nil
+	}
+	return subscription.Subscribers, nil
+}
+
+// SubscribeBulk subscribes the current user to many recordings concurrently,
+// at most opts.Concurrency at a time (DefaultBatchConcurrency by default).
+// Each recording is a Subscribe, so hooks observe Subscriptions.Subscribe per
+// recording.
+//
+// SubscribeBulk attempts every recording even if some fail. It returns the
+// IDs it subscribed to, in ascending order, and a *BulkError listing each
+// failure by recording ID, or nil if none failed.
+func (s *SubscriptionsService) SubscribeBulk(ctx context.Context, recordingIDs []int64, opts *BatchOptions) ([]int64, error) {
+	return runBatch(recordingIDs, opts, func(id int64) error {
+		_, err := s.Subscribe(ctx, id)
+		return err
+	})
+}
+
+// UnsubscribeBulk unsubscribes the current user from many recordings
+// concurrently. It behaves like SubscribeBulk, with hooks observing
+// Subscriptions.Unsubscribe per recording.
+func (s *SubscriptionsService) UnsubscribeBulk(ctx context.Context, recordingIDs []int64, opts *BatchOptions) ([]int64, error) {
+	return runBatch(recordingIDs, opts, func(id int64) error {
+		return s.Unsubscribe(ctx, id)
+	})
+}
+
 // subscriptionFromGenerated converts a generated Subscription to our clean type.
 func subscriptionFromGenerated(gs generated.Subscription) Subscription {
 	s := Subscription{
@@ -1,9 +1,17 @@
 package basecamp
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 )
 
@@ -233,3 +241,113 @@ func TestUpdateSubscriptionRequest_MarshalOmitsEmpty(t *testing.T) {
 		t.Error("unsubscriptions should be omitted when empty")
 	}
 }
+
+func testSubscriptionsServer(t *testing.T, handler http.HandlerFunc) *SubscriptionsService {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	cfg := DefaultConfig()
+	cfg.BaseURL = server.URL
+	client := NewClient(cfg, &StaticTokenProvider{Token: "test-token"})
+	return client.ForAccount("99999").Subscriptions()
+}
+
+func TestSubscriptionsService_ListSubscribers(t *testing.T) {
+	fixture := loadSubscriptionsFixture(t, "get.json")
+	svc := testSubscriptionsServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/99999/recordings/42/subscription.json" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(fixture)
+	})
+
+	people, err := svc.ListSubscribers(context.Background(), 42)
+	if err != nil {
+		t.Fatalf("ListSubscribers() error = %v", err)
+	}
+
+	var want Subscription
+	if err := json.Unmarshal(fixture, &want); err != nil {
+		t.Fatal(err)
+	}
+	if len(people) == 0 || len(people) != len(want.Subscribers) {
+		t.Fatalf("ListSubscribers() returned %d people, want %d", len(people), len(want.Subscribers))
+	}
+	if people[0].ID != want.Subscribers[0].ID || people[0].Name != want.Subscribers[0].Name {
+		t.Errorf("first subscriber = %+v, want %+v", people[0], want.Subscribers[0])
+	}
+}
+
+func TestSubscriptionsService_ListSubscribers_Empty(t *testing.T) {
+	svc := testSubscriptionsServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"subscribed":false,"count":0,"url":"https://example.com","subscribers":[]}`))
+	})
+
+	people, err := svc.ListSubscribers(context.Background(), 42)
+	if err != nil {
+		t.Fatalf("ListSubscribers() error = %v", err)
+	}
+	if people == nil || len(people) != 0 {
+		t.Errorf("ListSubscribers() = %#v, want non-nil empty slice", people)
+	}
+}
+
+func TestSubscriptionsService_SubscribeBulk(t *testing.T) {
+	fixture := loadSubscriptionsFixture(t, "subscribe.json")
+	var mu sync.Mutex
+	var paths []string
+	svc := testSubscriptionsServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("method = %s, want POST", r.Method)
+		}
+		mu.Lock()
+		paths = append(paths, r.URL.Path)
+		mu.Unlock()
+		if strings.Contains(r.URL.Path, "/2/") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(fixture)
+	})
+
+	succeeded, err := svc.SubscribeBulk(context.Background(), []int64{3, 2, 1}, nil)
+	if len(paths) != 3 {
+		t.Errorf("made %d requests, want 3", len(paths))
+	}
+	if !slices.Equal(succeeded, []int64{1, 3}) {
+		t.Errorf("succeeded = %v, want [1 3]", succeeded)
+	}
+	bulkErr, ok := errors.AsType[*BulkError](err)
+	if !ok {
+		t.Fatalf("SubscribeBulk() error = %v, want *BulkError", err)
+	}
+	if len(bulkErr.Errors) != 1 || bulkErr.Errors[0].ResourceID != 2 || !IsNotFound(err) {
+		t.Errorf("BulkError = %+v, want one not-found failure for recording 2", bulkErr.Errors)
+	}
+}
+
+func TestSubscriptionsService_UnsubscribeBulk(t *testing.T) {
+	var count atomic.Int32
+	svc := testSubscriptionsServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("method = %s, want DELETE", r.Method)
+		}
+		count.Add(1)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	succeeded, err := svc.UnsubscribeBulk(context.Background(), []int64{1, 2}, &BatchOptions{Concurrency: 1})
+	if err != nil {
+		t.Fatalf("UnsubscribeBulk() error = %v", err)
+	}
+	if count.Load() != 2 || !slices.Equal(succeeded, []int64{1, 2}) {
+		t.Errorf("made %d requests, succeeded = %v, want 2 and [1 2]", count.Load(), succeeded)
+	}
+	if _, err := svc.UnsubscribeBulk(context.Background(), nil, nil); err != nil {
+		t.Errorf("UnsubscribeBulk(nil) error = %v", err)
+	}
+}
@@ -94,6 +94,7 @@ func (s *TemplatesService) List(ctx context.Context, opts *TemplateListOptions)
 	op := OperationInfo{
 		Service: "Templates", Operation: "List",
 		ResourceType: "template", IsMutation: false,
+		AccountID: s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -114,6 +115,7 @@ func (s *TemplatesService) List(ctx context.Context, opts *TemplateListOptions)
 
 	// Capture total count from X-Total-Count header
 	totalCount := parseTotalCount(resp.HTTPResponse)
+	totalPages := parseTotalPages(resp.HTTPResponse, firstPageLen(resp.JSON200))
 
 	// Parse first page
 	var templates []Template
@@ -125,7 +127,7 @@ func (s *TemplatesService) List(ctx context.Context, opts *TemplateListOptions)
 
 	// Handle single page fetch (--page flag)
 	if opts != nil && opts.Page > 0 {
-		return &TemplateListResult{Templates: templates, Meta: ListMeta{TotalCount: totalCount}}, nil
+		return &TemplateListResult{Templates: templates, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages}}, nil
 	}
 
 	// Determine limit: 0 = all (no limit)
@@ -140,7 +142,7 @@ func (s *TemplatesService) List(ctx context.Context, opts *TemplateListOptions)
 
 	// Check if we already have enough items
 	if limit > 0 && len(templates) >= limit {
-		return &TemplateListResult{Templates: templates[:limit], Meta: ListMeta{TotalCount: totalCount, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(templates), limit)}}, nil
+		return &TemplateListResult{Templates: templates[:limit], Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(templates), limit)}}, nil
 	}
 
 	// Follow pagination via Link headers
@@ -158,7 +160,7 @@ func (s *TemplatesService) List(ctx context.Context, opts *TemplateListOptions)
 		templates = append(templates, templateFromGenerated(gt))
 	}
 
-	return &TemplateListResult{Templates: templates, Meta: ListMeta{TotalCount: totalCount, Truncated: truncated}}, nil
+	return &TemplateListResult{Templates: templates, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: truncated}}, nil
 }
 
 // Get returns a template by ID.
@@ -167,6 +169,7 @@ func (s *TemplatesService) Get(ctx context.Context, templateID int64) (result *T
 		Service: "Templates", Operation: "Get",
 		ResourceType: "template", IsMutation: false,
 		ResourceID: templateID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -199,6 +202,7 @@ func (s *TemplatesService) Create(ctx context.Context, req *CreateTemplateReques
 	op := OperationInfo{
 		Service: "Templates", Operation: "Create",
 		ResourceType: "template", IsMutation: true,
+		AccountID: s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -242,6 +246,7 @@ func (s *TemplatesService) Update(ctx context.Context, templateID int64, req *Up
 		Service: "Templates", Operation: "Update",
 		ResourceType: "template", IsMutation: true,
 		ResourceID: templateID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -286,6 +291,7 @@ func (s *TemplatesService) Delete(ctx context.Context, templateID int64) (err er
 		Service: "Templates", Operation: "Delete",
 		ResourceType: "template", IsMutation: true,
 		ResourceID: templateID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -310,6 +316,7 @@ func (s *TemplatesService) CreateProject(ctx context.Context, templateID int64,
 		Service: "Templates", Operation: "CreateProject",
 		ResourceType: "project_construction", IsMutation: true,
 		ResourceID: templateID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -354,6 +361,7 @@ func (s *TemplatesService) GetConstruction(ctx context.Context, templateID, cons
 		Service: "Templates", Operation: "GetConstruction",
 		ResourceType: "project_construction", IsMutation: false,
 		ResourceID: constructionID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -79,6 +79,7 @@ func (s *TimelineService) Progress(ctx context.Context, opts *TimelineListOption
 	op := OperationInfo{
 		Service: "Timeline", Operation: "Progress",
 		ResourceType: "timeline_event", IsMutation: false,
+		AccountID: s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -99,6 +100,7 @@ func (s *TimelineService) Progress(ctx context.Context, opts *TimelineListOption
 	}
 
 	totalCount := parseTotalCount(resp.HTTPResponse)
+	totalPages := parseTotalPages(resp.HTTPResponse, firstPageLen(resp.JSON200))
 
 	var events []TimelineEvent
 	if resp.JSON200 != nil {
@@ -108,7 +110,7 @@ func (s *TimelineService) Progress(ctx context.Context, opts *TimelineListOption
 	}
 
 	if opts != nil && opts.Page > 0 {
-		return &TimelineListResult{Events: events, Meta: ListMeta{TotalCount: totalCount}}, nil
+		return &TimelineListResult{Events: events, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages}}, nil
 	}
 
 	limit := DefaultTimelineLimit
@@ -121,7 +123,7 @@ func (s *TimelineService) Progress(ctx context.Context, opts *TimelineListOption
 	}
 
 	if limit > 0 && len(events) >= limit {
-		return &TimelineListResult{Events: events[:limit], Meta: ListMeta{TotalCount: totalCount, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(events), limit)}}, nil
+		return &TimelineListResult{Events: events[:limit], Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(events), limit)}}, nil
 	}
 
 	rawMore, truncated, err := s.client.parent.followPagination(ctx, resp.HTTPResponse, len(events), limit)
@@ -137,7 +139,7 @@ func (s *TimelineService) Progress(ctx context.Context, opts *TimelineListOption
 		events = append(events, timelineEventFromGenerated(ge))
 	}
 
-	return &TimelineListResult{Events: events, Meta: ListMeta{TotalCount: totalCount, Truncated: truncated}}, nil
+	return &TimelineListResult{Events: events, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: truncated}}, nil
 }
 
 // ProjectTimeline returns the activity timeline for a specific project.
@@ -152,6 +154,7 @@ func (s *TimelineService) ProjectTimeline(ctx context.Context, projectID int64,
 	op := OperationInfo{
 		Service: "Timeline", Operation: "ProjectTimeline",
 		ResourceType: "timeline_event", IsMutation: false,
+		AccountID: s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -172,6 +175,7 @@ func (s *TimelineService) ProjectTimeline(ctx context.Context, projectID int64,
 	}
 
 	totalCount := parseTotalCount(resp.HTTPResponse)
+	totalPages := parseTotalPages(resp.HTTPResponse, firstPageLen(resp.JSON200))
 
 	var events []TimelineEvent
 	if resp.JSON200 != nil {
@@ -181,7 +185,7 @@ func (s *TimelineService) ProjectTimeline(ctx context.Context, projectID int64,
 	}
 
 	if opts != nil && opts.Page > 0 {
-		return &TimelineListResult{Events: events, Meta: ListMeta{TotalCount: totalCount}}, nil
+		return &TimelineListResult{Events: events, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages}}, nil
 	}
 
 	limit := DefaultTimelineLimit
@@ -194,7 +198,7 @@ func (s *TimelineService) ProjectTimeline(ctx context.Context, projectID int64,
 	}
 
 	if limit > 0 && len(events) >= limit {
-		return &TimelineListResult{Events: events[:limit], Meta: ListMeta{TotalCount: totalCount, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(events), limit)}}, nil
+		return &TimelineListResult{Events: events[:limit], Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(events), limit)}}, nil
 	}
 
 	rawMore, truncated, err := s.client.parent.followPagination(ctx, resp.HTTPResponse, len(events), limit)
@@ -210,7 +214,7 @@ func (s *TimelineService) ProjectTimeline(ctx context.Context, projectID int64,
 		events = append(events, timelineEventFromGenerated(ge))
 	}
 
-	return &TimelineListResult{Events: events, Meta: ListMeta{TotalCount: totalCount, Truncated: truncated}}, nil
+	return &TimelineListResult{Events: events, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: truncated}}, nil
 }
 
 // PersonProgress returns the activity timeline for a specific person.
@@ -230,6 +234,7 @@ func (s *TimelineService) PersonProgress(ctx context.Context, personID int64, op
 		Service: "Timeline", Operation: "PersonProgress",
 		ResourceType: "timeline_event", IsMutation: false,
 		ResourceID: personID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -252,6 +257,7 @@ func (s *TimelineService) PersonProgress(ctx context.Context, personID int64, op
 	}
 
 	totalCount := parseTotalCount(resp.HTTPResponse)
+	totalPages := parseTotalPages(resp.HTTPResponse, len(resp.JSON200.Events))
 
 	// Extract person from first page
 	var person *Person
@@ -267,7 +273,7 @@ func (s *TimelineService) PersonProgress(ctx context.Context, personID int64, op
 	}
 
 	if opts != nil && opts.Page > 0 {
-		return &PersonProgressResult{Person: person, Events: events, Meta: ListMeta{TotalCount: totalCount}}, nil
+		return &PersonProgressResult{Person: person, Events: events, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages}}, nil
 	}
 
 	limit := DefaultTimelineLimit
@@ -283,7 +289,7 @@ func (s *TimelineService) PersonProgress(ctx context.Context, personID int64, op
 		return &PersonProgressResult{
 			Person: person,
 			Events: events[:limit],
-			Meta:   ListMeta{TotalCount: totalCount, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(events), limit)},
+			Meta:   ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(events), limit)},
 		}, nil
 	}
 
@@ -362,7 +368,7 @@ func (s *TimelineService) PersonProgress(ctx context.Context, personID int64, op
 		}
 	}
 
-	return &PersonProgressResult{Person: person, Events: events, Meta: ListMeta{TotalCount: totalCount, Truncated: truncated}}, nil
+	return &PersonProgressResult{Person: person, Events: events, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: truncated}}, nil
 }
 
 // timelineEventFromGenerated converts a generated TimelineEvent to our clean type.
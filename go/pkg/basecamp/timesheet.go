@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/basecamp/basecamp-sdk/go/pkg/generated"
@@ -73,6 +74,40 @@ type TimesheetReportOptions struct {
 	Page int
 }
 
+// TimesheetListOptions specifies a date range and pagination for
+// ListForProject and ListForPerson.
+type TimesheetListOptions struct {
+	// From filters entries on or after this date. Only the date is sent.
+	From time.Time
+	// To filters entries on or before this date. Only the date is sent.
+	To time.Time
+
+	// Limit is the maximum number of entries to return.
+	// If 0 (default), returns all entries.
+	Limit int
+
+	// Page, if positive, disables pagination and returns only the first page.
+	Page int
+}
+
+// reportOptions converts the options to TimesheetReportOptions, formatting
+// non-zero dates as YYYY-MM-DD.
+func (o *TimesheetListOptions) reportOptions(personID int64) *TimesheetReportOptions {
+	ro := &TimesheetReportOptions{PersonID: personID}
+	if o == nil {
+		return ro
+	}
+	if !o.From.IsZero() {
+		ro.From = o.From.Format(time.DateOnly)
+	}
+	if !o.To.IsZero() {
+		ro.To = o.To.Format(time.DateOnly)
+	}
+	ro.Limit = o.Limit
+	ro.Page = o.Page
+	return ro
+}
+
 // TimesheetService handles timesheet report operations.
 type TimesheetService struct {
 	client *AccountClient
@@ -108,6 +143,7 @@ func (s *TimesheetService) Report(ctx context.Context, opts *TimesheetReportOpti
 	op := OperationInfo{
 		Service: "Timesheet", Operation: "Report",
 		ResourceType: "timesheet_entry", IsMutation: false,
+		AccountID: s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -152,6 +188,7 @@ func (s *TimesheetService) ProjectReport(ctx context.Context, projectID int64, o
 	op := OperationInfo{
 		Service: "Timesheet", Operation: "ProjectReport",
 		ResourceType: "timesheet_entry", IsMutation: false,
+		AccountID: s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -179,49 +216,57 @@ func (s *TimesheetService) ProjectReport(ctx context.Context, projectID int64, o
 		return nil, err
 	}
 
-	// Capture total count from X-Total-Count header (first page only)
-	totalCount := parseTotalCount(resp.HTTPResponse)
+	return s.collectEntries(ctx, resp.HTTPResponse, resp.JSON200, opts)
+}
 
-	// Parse first page
-	var entries []TimesheetEntry
-	if resp.JSON200 != nil {
-		for _, ge := range *resp.JSON200 {
-			entries = append(entries, timesheetEntryFromGenerated(ge))
-		}
-	}
+// ListForProject returns a project's timesheet entries within the date range
+// in opts, following all pages unless opts sets Limit or Page.
+//
+// ListForProject is ProjectReport with typed dates; hooks observe the
+// Timesheet.ProjectReport operation.
+func (s *TimesheetService) ListForProject(ctx context.Context, projectID int64, opts *TimesheetListOptions) (*TimesheetListResult, error) {
+	return s.ProjectReport(ctx, projectID, opts.reportOptions(0))
+}
 
-	// Handle single page fetch (--page flag)
-	if opts != nil && opts.Page > 0 {
-		return &TimesheetListResult{Entries: entries, Meta: ListMeta{TotalCount: totalCount}}, nil
+// ListForPerson returns one person's timesheet entries across the account
+// within the date range in opts, following all pages unless opts sets Limit
+// or Page.
+//
+// Unlike Report, ListForPerson follows pagination; hooks observe it as the
+// Timesheet.Report operation, since both call GetTimesheetReport.
+//
+// The returned TimesheetListResult includes pagination metadata (TotalCount from
+// the X-Total-Count header, and TotalPages derived from it) when available.
+func (s *TimesheetService) ListForPerson(ctx context.Context, personID int64, opts *TimesheetListOptions) (result *TimesheetListResult, err error) {
+	op := OperationInfo{
+		Service: "Timesheet", Operation: "Report",
+		ResourceType: "timesheet_entry", IsMutation: false,
+		AccountID: s.client.accountID,
 	}
-
-	// Determine limit: 0 = all (default for timesheet entries)
-	limit := 0
-	if opts != nil {
-		limit = opts.Limit
+	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
+		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
+			return
+		}
 	}
+	start := time.Now()
+	ctx = s.client.parent.hooks.OnOperationStart(ctx, op)
+	defer func() { s.client.parent.hooks.OnOperationEnd(ctx, op, err, time.Since(start)) }()
 
-	// Check if we already have enough items
-	if limit > 0 && len(entries) >= limit {
-		return &TimesheetListResult{Entries: entries[:limit], Meta: ListMeta{TotalCount: totalCount, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(entries), limit)}}, nil
+	if personID <= 0 {
+		err = ErrUsage("person ID must be positive")
+		return nil, err
 	}
 
-	// Follow pagination via Link headers (uses absolute URLs from API, no path construction)
-	rawMore, truncated, err := s.client.parent.followPagination(ctx, resp.HTTPResponse, len(entries), limit)
+	reportOpts := opts.reportOptions(personID)
+	resp, err := s.client.parent.gen.GetTimesheetReportWithResponse(ctx, s.client.accountID, s.buildTimesheetParams(reportOpts))
 	if err != nil {
 		return nil, err
 	}
-
-	// Parse additional pages
-	for _, raw := range rawMore {
-		var ge generated.TimesheetEntry
-		if err := json.Unmarshal(raw, &ge); err != nil {
-			return nil, fmt.Errorf("failed to parse timesheet entry: %w", err)
-		}
-		entries = append(entries, timesheetEntryFromGenerated(ge))
+	if err = checkResponse(resp.HTTPResponse, resp.Body); err != nil {
+		return nil, err
 	}
 
-	return &TimesheetListResult{Entries: entries, Meta: ListMeta{TotalCount: totalCount, Truncated: truncated}}, nil
+	return s.collectEntries(ctx, resp.HTTPResponse, resp.JSON200, reportOpts)
 }
 
 // RecordingReport returns the timesheet report for a specific recording.
@@ -238,6 +283,7 @@ func (s *TimesheetService) RecordingReport(ctx context.Context, recordingID int6
 		Service: "Timesheet", Operation: "RecordingReport",
 		ResourceType: "timesheet_entry", IsMutation: false,
 		ResourceID: recordingID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -265,20 +311,28 @@ func (s *TimesheetService) RecordingReport(ctx context.Context, recordingID int6
 		return nil, err
 	}
 
+	return s.collectEntries(ctx, resp.HTTPResponse, resp.JSON200, opts)
+}
+
+// collectEntries converts the first page of a paginated timesheet report and,
+// unless opts sets Page, follows the Link headers for the remaining pages up
+// to opts.Limit (0 = all).
+func (s *TimesheetService) collectEntries(ctx context.Context, resp *http.Response, firstPage *[]generated.TimesheetEntry, opts *TimesheetReportOptions) (*TimesheetListResult, error) {
 	// Capture total count from X-Total-Count header (first page only)
-	totalCount := parseTotalCount(resp.HTTPResponse)
+	totalCount := parseTotalCount(resp)
+	totalPages := parseTotalPages(resp, firstPageLen(firstPage))
 
 	// Parse first page
 	var entries []TimesheetEntry
-	if resp.JSON200 != nil {
-		for _, ge := range *resp.JSON200 {
+	if firstPage != nil {
+		for _, ge := range *firstPage {
 			entries = append(entries, timesheetEntryFromGenerated(ge))
 		}
 	}
 
 	// Handle single page fetch (--page flag)
 	if opts != nil && opts.Page > 0 {
-		return &TimesheetListResult{Entries: entries, Meta: ListMeta{TotalCount: totalCount}}, nil
+		return &TimesheetListResult{Entries: entries, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages}}, nil
 	}
 
 	// Determine limit: 0 = all (default for timesheet entries)
@@ -289,11 +343,11 @@ func (s *TimesheetService) RecordingReport(ctx context.Context, recordingID int6
 
 	// Check if we already have enough items
 	if limit > 0 && len(entries) >= limit {
-		return &TimesheetListResult{Entries: entries[:limit], Meta: ListMeta{TotalCount: totalCount, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(entries), limit)}}, nil
+		return &TimesheetListResult{Entries: entries[:limit], Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: isFirstPageTruncated(resp, len(entries), limit)}}, nil
 	}
 
 	// Follow pagination via Link headers (uses absolute URLs from API, no path construction)
-	rawMore, truncated, err := s.client.parent.followPagination(ctx, resp.HTTPResponse, len(entries), limit)
+	rawMore, truncated, err := s.client.parent.followPagination(ctx, resp, len(entries), limit)
 	if err != nil {
 		return nil, err
 	}
@@ -307,7 +361,7 @@ func (s *TimesheetService) RecordingReport(ctx context.Context, recordingID int6
 		entries = append(entries, timesheetEntryFromGenerated(ge))
 	}
 
-	return &TimesheetListResult{Entries: entries, Meta: ListMeta{TotalCount: totalCount, Truncated: truncated}}, nil
+	return &TimesheetListResult{Entries: entries, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: truncated}}, nil
 }
 
 // Get returns a single timesheet entry.
@@ -316,6 +370,7 @@ func (s *TimesheetService) Get(ctx context.Context, entryID int64) (result *Time
 		Service: "Timesheet", Operation: "Get",
 		ResourceType: "timesheet_entry", IsMutation: false,
 		ResourceID: entryID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -347,6 +402,7 @@ func (s *TimesheetService) Create(ctx context.Context, recordingID int64, req *C
 	op := OperationInfo{
 		Service: "Timesheet", Operation: "Create",
 		ResourceType: "timesheet_entry", IsMutation: true,
+		AccountID: s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -397,6 +453,7 @@ func (s *TimesheetService) Update(ctx context.Context, entryID int64, req *Updat
 		Service: "Timesheet", Operation: "Update",
 		ResourceType: "timesheet_entry", IsMutation: true,
 		ResourceID: entryID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -443,6 +500,7 @@ func (s *TimesheetService) Trash(ctx context.Context, entryID int64) (err error)
 		Service: "Timesheet", Operation: "Trash",
 		ResourceType: "timesheet_entry", IsMutation: true,
 		ResourceID: entryID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
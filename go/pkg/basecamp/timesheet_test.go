@@ -1,10 +1,16 @@
 package basecamp
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 )
 
 func timesheetFixturesDir() string {
@@ -493,3 +499,103 @@ func TestTimesheetReportOptions_BuildTimesheetParams(t *testing.T) {
 		})
 	}
 }
+
+// testTimesheetServer serves two pages of timesheet entries at path and
+// records the query of each request.
+func testTimesheetServer(t *testing.T, path string, queries *[]url.Values, opts ...ClientOption) *TimesheetService {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != path {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		*queries = append(*queries, r.URL.Query())
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("page") == "2" {
+			w.Write([]byte(`[{"id":3,"hours":"1.0"}]`))
+			return
+		}
+		next := r.URL.Query()
+		next.Set("page", "2")
+		w.Header().Set("Link", fmt.Sprintf(`<http://%s%s?%s>; rel="next"`, r.Host, path, next.Encode()))
+		w.Write([]byte(`[{"id":1,"hours":"2.5"},{"id":2,"hours":"0.5"}]`))
+	}))
+	t.Cleanup(server.Close)
+
+	cfg := DefaultConfig()
+	cfg.BaseURL = server.URL
+	client := NewClient(cfg, &StaticTokenProvider{Token: "test-token"}, opts...)
+	return client.ForAccount("99999").Timesheet()
+}
+
+func TestTimesheetService_ListForProject(t *testing.T) {
+	var queries []url.Values
+	svc := testTimesheetServer(t, "/99999/projects/123/timesheet.json", &queries)
+
+	result, err := svc.ListForProject(context.Background(), 123, &TimesheetListOptions{
+		From: time.Date(2024, 1, 1, 15, 30, 0, 0, time.UTC),
+		To:   time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(queries) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(queries))
+	}
+	if got := queries[0].Get("from"); got != "2024-01-01" {
+		t.Errorf("from = %q, want %q", got, "2024-01-01")
+	}
+	if got := queries[0].Get("to"); got != "2024-01-31" {
+		t.Errorf("to = %q, want %q", got, "2024-01-31")
+	}
+	if queries[0].Has("person_id") {
+		t.Errorf("expected no person_id, got %q", queries[0].Get("person_id"))
+	}
+	if len(result.Entries) != 3 || result.Entries[2].ID != 3 {
+		t.Errorf("expected 3 entries across both pages, got %+v", result.Entries)
+	}
+}
+
+func TestTimesheetService_ListForPerson(t *testing.T) {
+	var queries []url.Values
+	hooks := &recordingHooks{}
+	svc := testTimesheetServer(t, "/99999/reports/timesheet.json", &queries, WithHooks(hooks))
+
+	result, err := svc.ListForPerson(context.Background(), 42, &TimesheetListOptions{
+		From: time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(queries) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(queries))
+	}
+	if got := queries[0].Get("person_id"); got != "42" {
+		t.Errorf("person_id = %q, want %q", got, "42")
+	}
+	if got := queries[0].Get("from"); got != "2024-03-05" {
+		t.Errorf("from = %q, want %q", got, "2024-03-05")
+	}
+	if queries[0].Has("to") {
+		t.Errorf("expected no to parameter for a zero To, got %q", queries[0].Get("to"))
+	}
+	if len(result.Entries) != 3 {
+		t.Errorf("expected 3 entries across both pages, got %d", len(result.Entries))
+	}
+	if len(hooks.opStartCalls) != 1 || hooks.opStartCalls[0].Operation != "Report" {
+		t.Errorf("unexpected operation info: %+v", hooks.opStartCalls)
+	}
+}
+
+func TestTimesheetService_ListForPerson_InvalidID(t *testing.T) {
+	var queries []url.Values
+	svc := testTimesheetServer(t, "/99999/reports/timesheet.json", &queries)
+
+	if _, err := svc.ListForPerson(context.Background(), 0, nil); ErrorCode(err) != CodeUsage {
+		t.Errorf("ListForPerson(0) error = %v, want usage error", err)
+	}
+	if len(queries) != 0 {
+		t.Errorf("expected no requests, got %d", len(queries))
+	}
+}
@@ -89,6 +89,7 @@ func (s *TodolistGroupsService) List(ctx context.Context, todolistID int64, opts
 		Service: "TodolistGroups", Operation: "List",
 		ResourceType: "todolist_group", IsMutation: false,
 		ResourceID: todolistID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -109,6 +110,7 @@ func (s *TodolistGroupsService) List(ctx context.Context, todolistID int64, opts
 
 	// Capture total count from X-Total-Count header
 	totalCount := parseTotalCount(resp.HTTPResponse)
+	totalPages := parseTotalPages(resp.HTTPResponse, firstPageLen(resp.JSON200))
 
 	// Parse first page
 	var groups []TodolistGroup
@@ -120,7 +122,7 @@ func (s *TodolistGroupsService) List(ctx context.Context, todolistID int64, opts
 
 	// Handle single page fetch (--page flag)
 	if opts != nil && opts.Page > 0 {
-		return &TodolistGroupListResult{Groups: groups, Meta: ListMeta{TotalCount: totalCount}}, nil
+		return &TodolistGroupListResult{Groups: groups, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages}}, nil
 	}
 
 	// Determine limit: 0 = all (no limit)
@@ -135,7 +137,7 @@ func (s *TodolistGroupsService) List(ctx context.Context, todolistID int64, opts
 
 	// Check if we already have enough items
 	if limit > 0 && len(groups) >= limit {
-		return &TodolistGroupListResult{Groups: groups[:limit], Meta: ListMeta{TotalCount: totalCount, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(groups), limit)}}, nil
+		return &TodolistGroupListResult{Groups: groups[:limit], Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(groups), limit)}}, nil
 	}
 
 	// Follow pagination via Link headers
@@ -153,7 +155,7 @@ func (s *TodolistGroupsService) List(ctx context.Context, todolistID int64, opts
 		groups = append(groups, todolistGroupFromGenerated(gg))
 	}
 
-	return &TodolistGroupListResult{Groups: groups, Meta: ListMeta{TotalCount: totalCount, Truncated: truncated}}, nil
+	return &TodolistGroupListResult{Groups: groups, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: truncated}}, nil
 }
 
 // Get returns a todolist group by ID.
@@ -162,6 +164,7 @@ func (s *TodolistGroupsService) Get(ctx context.Context, groupID int64) (result
 		Service: "TodolistGroups", Operation: "Get",
 		ResourceType: "todolist_group", IsMutation: false,
 		ResourceID: groupID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -203,6 +206,7 @@ func (s *TodolistGroupsService) Create(ctx context.Context, todolistID int64, re
 		Service: "TodolistGroups", Operation: "Create",
 		ResourceType: "todolist_group", IsMutation: true,
 		ResourceID: todolistID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -213,7 +217,7 @@ func (s *TodolistGroupsService) Create(ctx context.Context, todolistID int64, re
 	ctx = s.client.parent.hooks.OnOperationStart(ctx, op)
 	defer func() { s.client.parent.hooks.OnOperationEnd(ctx, op, err, time.Since(start)) }()
 
-	if req.Name == "" {
+	if req == nil || req.Name == "" {
 		err = ErrUsage("group name is required")
 		return nil, err
 	}
@@ -245,6 +249,7 @@ func (s *TodolistGroupsService) Update(ctx context.Context, groupID int64, req *
 		Service: "TodolistGroups", Operation: "Update",
 		ResourceType: "todolist_group", IsMutation: true,
 		ResourceID: groupID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -291,6 +296,7 @@ func (s *TodolistGroupsService) Reposition(ctx context.Context, groupID int64, p
 		Service: "TodolistGroups", Operation: "Reposition",
 		ResourceType: "todolist_group", IsMutation: true,
 		ResourceID: groupID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -324,6 +330,7 @@ func (s *TodolistGroupsService) Trash(ctx context.Context, groupID int64) (err e
 		Service: "TodolistGroups", Operation: "Trash",
 		ResourceType: "todolist_group", IsMutation: true,
 		ResourceID: groupID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -3,6 +3,7 @@ package basecamp
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -246,6 +247,92 @@ func TestTodolistGroupsService_Update(t *testing.T) {
 	}
 }
 
+func TestTodolistGroupsService_Create(t *testing.T) {
+	fixture := loadTodolistGroupsFixture(t, "get.json")
+	var receivedBody map[string]any
+	svc := testTodolistGroupsServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Errorf("expected POST, got %s", r.Method)
+		}
+		if r.URL.Path != "/99999/todolists/1069479519/groups.json" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		body, _ := io.ReadAll(r.Body)
+		json.Unmarshal(body, &receivedBody)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(201)
+		w.Write(fixture)
+	})
+
+	group, err := svc.Create(context.Background(), 1069479519, &CreateTodolistGroupRequest{Name: "Phase 1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if group.ID != 1069479600 {
+		t.Errorf("expected ID 1069479600, got %d", group.ID)
+	}
+	if len(receivedBody) != 1 || receivedBody["name"] != "Phase 1" {
+		t.Errorf("expected request body {name: Phase 1}, got %v", receivedBody)
+	}
+}
+
+func TestTodolistGroupsService_Create_RequiresName(t *testing.T) {
+	svc := testTodolistGroupsServer(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("no request expected without a name")
+	})
+
+	for _, req := range []*CreateTodolistGroupRequest{nil, {}} {
+		_, err := svc.Create(context.Background(), 1069479519, req)
+		if apiErr, ok := errors.AsType[*Error](err); !ok || apiErr.Code != CodeUsage {
+			t.Errorf("expected usage error, got %v", err)
+		}
+	}
+}
+
+func TestTodolistGroupsService_Reposition(t *testing.T) {
+	var receivedBody map[string]any
+	svc := testTodolistGroupsServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" {
+			t.Errorf("expected PUT, got %s", r.Method)
+		}
+		if r.URL.Path != "/99999/todolists/1069479600/position.json" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		body, _ := io.ReadAll(r.Body)
+		json.Unmarshal(body, &receivedBody)
+		w.WriteHeader(204)
+	})
+
+	if err := svc.Reposition(context.Background(), 1069479600, 2); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if receivedBody["position"] != float64(2) {
+		t.Errorf("expected request body position 2, got %v", receivedBody["position"])
+	}
+
+	err := svc.Reposition(context.Background(), 1069479600, 0)
+	if apiErr, ok := errors.AsType[*Error](err); !ok || apiErr.Code != CodeUsage {
+		t.Errorf("expected usage error for position 0, got %v", err)
+	}
+}
+
+func TestTodolistGroupsService_Trash(t *testing.T) {
+	svc := testTodolistGroupsServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" {
+			t.Errorf("expected PUT, got %s", r.Method)
+		}
+		if r.URL.Path != "/99999/recordings/1069479600/status/trashed.json" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		w.WriteHeader(204)
+	})
+
+	if err := svc.Trash(context.Background(), 1069479600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
 func TestTodolistGroup_TimestampParsing(t *testing.T) {
 	data := loadTodolistGroupsFixture(t, "get.json")
 
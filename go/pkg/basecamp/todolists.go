@@ -109,6 +109,7 @@ func (s *TodolistsService) List(ctx context.Context, todosetID int64, opts *Todo
 		Service: "Todolists", Operation: "List",
 		ResourceType: "todolist", IsMutation: false,
 		ResourceID: todosetID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -136,6 +137,7 @@ func (s *TodolistsService) List(ctx context.Context, todosetID int64, opts *Todo
 
 	// Capture total count from X-Total-Count header (first page only)
 	totalCount := parseTotalCount(resp.HTTPResponse)
+	totalPages := parseTotalPages(resp.HTTPResponse, firstPageLen(resp.JSON200))
 
 	// Parse first page
 	var todolists []Todolist
@@ -147,7 +149,7 @@ func (s *TodolistsService) List(ctx context.Context, todosetID int64, opts *Todo
 
 	// Handle single page fetch (--page flag)
 	if opts != nil && opts.Page > 0 {
-		return &TodolistListResult{Todolists: todolists, Meta: ListMeta{TotalCount: totalCount}}, nil
+		return &TodolistListResult{Todolists: todolists, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages}}, nil
 	}
 
 	// Determine limit: 0 = all (default for todolists), >0 = specific limit
@@ -158,7 +160,7 @@ func (s *TodolistsService) List(ctx context.Context, todosetID int64, opts *Todo
 
 	// Check if we already have enough items
 	if limit > 0 && len(todolists) >= limit {
-		return &TodolistListResult{Todolists: todolists[:limit], Meta: ListMeta{TotalCount: totalCount, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(todolists), limit)}}, nil
+		return &TodolistListResult{Todolists: todolists[:limit], Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(todolists), limit)}}, nil
 	}
 
 	// Follow pagination via Link headers (uses absolute URLs from API, no path construction)
@@ -176,7 +178,7 @@ func (s *TodolistsService) List(ctx context.Context, todosetID int64, opts *Todo
 		todolists = append(todolists, todolistFromGenerated(gtl))
 	}
 
-	return &TodolistListResult{Todolists: todolists, Meta: ListMeta{TotalCount: totalCount, Truncated: truncated}}, nil
+	return &TodolistListResult{Todolists: todolists, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: truncated}}, nil
 }
 
 // Get returns a todolist by ID.
@@ -185,6 +187,7 @@ func (s *TodolistsService) Get(ctx context.Context, todolistID int64) (result *T
 		Service: "Todolists", Operation: "Get",
 		ResourceType: "todolist", IsMutation: false,
 		ResourceID: todolistID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -225,6 +228,7 @@ func (s *TodolistsService) Create(ctx context.Context, todosetID int64, req *Cre
 		Service: "Todolists", Operation: "Create",
 		ResourceType: "todolist", IsMutation: true,
 		ResourceID: todosetID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -268,6 +272,7 @@ func (s *TodolistsService) Update(ctx context.Context, todolistID int64, req *Up
 		Service: "Todolists", Operation: "Update",
 		ResourceType: "todolist", IsMutation: true,
 		ResourceID: todolistID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -316,6 +321,7 @@ func (s *TodolistsService) Trash(ctx context.Context, todolistID int64) (err err
 		Service: "Todolists", Operation: "Trash",
 		ResourceType: "todolist", IsMutation: true,
 		ResourceID: todolistID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -342,6 +348,7 @@ func (s *TodolistsService) Reposition(ctx context.Context, todolistID int64, pos
 		Service: "Todolists", Operation: "Reposition",
 		ResourceType: "todolist", IsMutation: true,
 		ResourceID: todolistID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
+	"slices"
 	"time"
 
 	"github.com/basecamp/basecamp-sdk/go/pkg/generated"
@@ -105,6 +107,15 @@ type Person struct {
 	CanManagePeople     bool           `json:"can_manage_people,omitempty"`
 }
 
+// DisplayName returns the person's name, or their email address when the
+// name is empty.
+func (p Person) DisplayName() string {
+	if p.Name != "" {
+		return p.Name
+	}
+	return p.EmailAddress
+}
+
 // PersonCompany represents a company associated with a person.
 type PersonCompany struct {
 	ID   int64  `json:"id"`
@@ -186,6 +197,28 @@ type TodoListOptions struct {
 	// to list archived completed todos).
 	Completed bool
 
+	// The fields below narrow the results further. The todos endpoint has no
+	// query parameters for them, so List fetches every page and filters the
+	// todos itself before applying Limit; Meta.TotalCount still reports the
+	// server's unfiltered count. Dates are YYYY-MM-DD and bounds are
+	// exclusive. Todos without the relevant date never match a date filter.
+
+	// AssigneeIDs keeps todos assigned to at least one of these people.
+	AssigneeIDs []int64
+
+	// DueOnBefore and DueOnAfter keep todos due before / after the date.
+	DueOnBefore string
+	DueOnAfter  string
+
+	// StartsOnBefore and StartsOnAfter keep todos starting before / after
+	// the date.
+	StartsOnBefore string
+	StartsOnAfter  string
+
+	// Overdue keeps incomplete todos due before today (local time). An
+	// explicit DueOnBefore takes the place of today.
+	Overdue bool
+
 	// Limit is the maximum number of todos to return.
 	// If 0, uses DefaultTodoLimit (100). Use -1 for unlimited.
 	Limit int
@@ -364,12 +397,16 @@ func NewTodosService(client *AccountClient) *TodosService {
 //   - Limit: maximum number of todos to return (0 = 100, -1 = unlimited)
 //   - Page: if non-zero, disables pagination and returns first page only
 //
+// Filters beyond Status and Completed (AssigneeIDs, the due and start date
+// bounds, Overdue) are applied by the client; see TodoListOptions.
+//
 // The returned TodoListResult includes pagination metadata (TotalCount from
 // X-Total-Count header) when available.
 func (s *TodosService) List(ctx context.Context, todolistID int64, opts *TodoListOptions) (result *TodoListResult, err error) {
 	op := OperationInfo{
 		Service: "Todos", Operation: "List",
 		ResourceType: "todo", IsMutation: false,
+		AccountID: s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -384,6 +421,10 @@ func (s *TodosService) List(ctx context.Context, todolistID int64, opts *TodoLis
 		err = ErrUsage(fmt.Sprintf("todo list status must be empty, %q, or %q (got %q)", "archived", "trashed", opts.Status))
 		return nil, err
 	}
+	filter, err := newTodoFilter(opts, time.Now())
+	if err != nil {
+		return nil, err
+	}
 
 	// Build params for generated client. Status and Completed are orthogonal
 	// upstream: Status filters by recording lifecycle (archived/trashed),
@@ -404,6 +445,7 @@ func (s *TodosService) List(ctx context.Context, todolistID int64, opts *TodoLis
 
 	// Capture total count from X-Total-Count header (first page only)
 	totalCount := parseTotalCount(resp.HTTPResponse)
+	totalPages := parseTotalPages(resp.HTTPResponse, firstPageLen(resp.JSON200))
 
 	// Parse first page
 	var todos []Todo
@@ -415,7 +457,7 @@ func (s *TodosService) List(ctx context.Context, todolistID int64, opts *TodoLis
 
 	// Handle single page fetch (--page flag)
 	if opts != nil && opts.Page > 0 {
-		return &TodoListResult{Todos: todos, Meta: ListMeta{TotalCount: totalCount}}, nil
+		return &TodoListResult{Todos: filter.apply(todos), Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages}}, nil
 	}
 
 	// Determine limit: 0 = default (100), -1 = unlimited, >0 = specific limit
@@ -428,9 +470,29 @@ func (s *TodosService) List(ctx context.Context, todolistID int64, opts *TodoLis
 		}
 	}
 
+	// Client-side filters need every page before the limit can be applied
+	if filter != nil {
+		rawMore, truncated, err := s.client.parent.followPagination(ctx, resp.HTTPResponse, len(todos), 0)
+		if err != nil {
+			return nil, err
+		}
+		for _, raw := range rawMore {
+			var gt generated.Todo
+			if err := json.Unmarshal(raw, &gt); err != nil {
+				return nil, fmt.Errorf("failed to parse todo: %w", err)
+			}
+			todos = append(todos, todoFromGenerated(gt))
+		}
+		todos = filter.apply(todos)
+		if limit > 0 && len(todos) > limit {
+			todos, truncated = todos[:limit], true
+		}
+		return &TodoListResult{Todos: todos, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: truncated}}, nil
+	}
+
 	// Check if we already have enough items
 	if limit > 0 && len(todos) >= limit {
-		return &TodoListResult{Todos: todos[:limit], Meta: ListMeta{TotalCount: totalCount, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(todos), limit)}}, nil
+		return &TodoListResult{Todos: todos[:limit], Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(todos), limit)}}, nil
 	}
 
 	// Follow pagination via Link headers (uses absolute URLs from API, no path construction)
@@ -448,7 +510,255 @@ func (s *TodosService) List(ctx context.Context, todolistID int64, opts *TodoLis
 		todos = append(todos, todoFromGenerated(gt))
 	}
 
-	return &TodoListResult{Todos: todos, Meta: ListMeta{TotalCount: totalCount, Truncated: truncated}}, nil
+	return &TodoListResult{Todos: todos, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: truncated}}, nil
+}
+
+// todoFilter holds the client-side filters from TodoListOptions.
+type todoFilter struct {
+	assignees                 map[int64]bool
+	dueBefore, dueAfter       string
+	startsBefore, startsAfter string
+	incompleteOnly            bool
+}
+
+// newTodoFilter validates the client-side filters in opts and returns nil
+// when none are set. now supplies today's date for Overdue.
+func newTodoFilter(opts *TodoListOptions, now time.Time) (*todoFilter, error) {
+	if opts == nil {
+		return nil, nil
+	}
+	for name, date := range map[string]string{
+		"DueOnBefore": opts.DueOnBefore, "DueOnAfter": opts.DueOnAfter,
+		"StartsOnBefore": opts.StartsOnBefore, "StartsOnAfter": opts.StartsOnAfter,
+	} {
+		if date == "" {
+			continue
+		}
+		if _, err := time.Parse(time.DateOnly, date); err != nil {
+			return nil, ErrUsage(fmt.Sprintf("%s must be a YYYY-MM-DD date (got %q)", name, date))
+		}
+	}
+
+	f := &todoFilter{
+		dueBefore:      opts.DueOnBefore,
+		dueAfter:       opts.DueOnAfter,
+		startsBefore:   opts.StartsOnBefore,
+		startsAfter:    opts.StartsOnAfter,
+		incompleteOnly: opts.Overdue,
+	}
+	if opts.Overdue && f.dueBefore == "" {
+		f.dueBefore = now.Format(time.DateOnly)
+	}
+	if len(opts.AssigneeIDs) > 0 {
+		f.assignees = make(map[int64]bool, len(opts.AssigneeIDs))
+		for _, id := range opts.AssigneeIDs {
+			f.assignees[id] = true
+		}
+	}
+	if f.assignees == nil && f.dueBefore == "" && f.dueAfter == "" &&
+		f.startsBefore == "" && f.startsAfter == "" && !f.incompleteOnly {
+		return nil, nil
+	}
+	return f, nil
+}
+
+// apply returns the todos that match every filter. A nil filter matches all.
+func (f *todoFilter) apply(todos []Todo) []Todo {
+	if f == nil {
+		return todos
+	}
+	matched := todos[:0]
+	for _, t := range todos {
+		if f.matches(&t) {
+			matched = append(matched, t)
+		}
+	}
+	return matched
+}
+
+func (f *todoFilter) matches(t *Todo) bool {
+	if f.incompleteOnly && t.Completed {
+		return false
+	}
+	// YYYY-MM-DD strings order the same as the dates they name.
+	if !dateInRange(t.DueOn, f.dueAfter, f.dueBefore) {
+		return false
+	}
+	if !dateInRange(t.StartsOn, f.startsAfter, f.startsBefore) {
+		return false
+	}
+	if f.assignees != nil {
+		for _, p := range t.Assignees {
+			if f.assignees[p.ID] {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// dateInRange reports whether date lies strictly between after and before,
+// either of which may be empty for an open bound. An empty date matches only
+// when both bounds are open.
+func dateInRange(date, after, before string) bool {
+	if after == "" && before == "" {
+		return true
+	}
+	if date == "" {
+		return false
+	}
+	return (after == "" || date > after) && (before == "" || date < before)
+}
+
+// TodoIterResult is a value received from TodosService.Iter: either a todo
+// or the error that ended the iteration.
+type TodoIterResult struct {
+	Todo *Todo
+	Err  error
+}
+
+// Unwrap returns the result's todo and error.
+func (r TodoIterResult) Unwrap() (*Todo, error) {
+	return r.Todo, r.Err
+}
+
+// Iter streams the todos in a todolist, fetching each page only as the
+// previous one has been consumed, so large lists are never held in memory.
+//
+// Status, Completed, Page and the client-side filters (AssigneeIDs,
+// DueOnBefore, Overdue, ...) behave as for List. Unlike List, a zero Limit
+// means no limit: all pages are followed (up to the client's MaxPages); a
+// positive Limit stops after that many todos.
+//
+// The returned channel is closed after the last todo or after a result
+// carrying the first error. Cancelling ctx stops the iteration: no further
+// requests are made and the channel is closed without an error result, so
+// callers that cancel should consult ctx.Err(). Callers that stop reading
+// early must cancel ctx to release the goroutine.
+func (s *TodosService) Iter(ctx context.Context, todolistID int64, opts *TodoListOptions) <-chan TodoIterResult {
+	ch := make(chan TodoIterResult)
+	go func() {
+		defer close(ch)
+		if err := s.iter(ctx, todolistID, opts, ch); err != nil && ctx.Err() == nil {
+			select {
+			case ch <- TodoIterResult{Err: err}:
+			case <-ctx.Done():
+			}
+		}
+	}()
+	return ch
+}
+
+func (s *TodosService) iter(ctx context.Context, todolistID int64, opts *TodoListOptions, ch chan<- TodoIterResult) (err error) {
+	op := OperationInfo{
+		Service: "Todos", Operation: "Iter",
+		ResourceType: "todo", IsMutation: false,
+		AccountID: s.client.accountID,
+	}
+	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
+		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
+			return
+		}
+	}
+	start := time.Now()
+	ctx = s.client.parent.hooks.OnOperationStart(ctx, op)
+	defer func() { s.client.parent.hooks.OnOperationEnd(ctx, op, err, time.Since(start)) }()
+
+	if opts != nil && opts.Status != "" && opts.Status != "archived" && opts.Status != "trashed" {
+		err = ErrUsage(fmt.Sprintf("todo list status must be empty, %q, or %q (got %q)", "archived", "trashed", opts.Status))
+		return err
+	}
+	filter, err := newTodoFilter(opts, time.Now())
+	if err != nil {
+		return err
+	}
+
+	var params *generated.ListTodosParams
+	if opts != nil && (opts.Status != "" || opts.Completed) {
+		params = &generated.ListTodosParams{Status: opts.Status, Completed: opts.Completed}
+	}
+
+	resp, err := s.client.parent.gen.ListTodosWithResponse(ctx, s.client.accountID, todolistID, params)
+	if err != nil {
+		return err
+	}
+	if err = checkResponse(resp.HTTPResponse, resp.Body); err != nil {
+		return err
+	}
+
+	limit := 0
+	if opts != nil && opts.Limit > 0 {
+		limit = opts.Limit
+	}
+	sent := 0
+
+	// emit delivers one todo and reports whether iteration should continue.
+	emit := func(gt generated.Todo) bool {
+		todo := todoFromGenerated(gt)
+		if filter != nil && !filter.matches(&todo) {
+			return true
+		}
+		select {
+		case ch <- TodoIterResult{Todo: &todo}:
+			sent++
+			return limit == 0 || sent < limit
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	if resp.JSON200 != nil {
+		for _, gt := range *resp.JSON200 {
+			if !emit(gt) {
+				return ctx.Err()
+			}
+		}
+	}
+	if opts != nil && opts.Page > 0 {
+		return nil
+	}
+
+	// Follow Link headers one page at a time, with the same origin checks
+	// as followPagination.
+	if resp.HTTPResponse.Request == nil || resp.HTTPResponse.Request.URL == nil {
+		return fmt.Errorf("cannot follow pagination: response has no request URL (required for same-origin validation)")
+	}
+	baseURL := resp.HTTPResponse.Request.URL.String()
+	currentURL := baseURL
+	nextLink := parseNextLink(resp.HTTPResponse.Header.Get("Link"))
+
+	for page := 2; nextLink != ""; page++ {
+		if page > s.client.parent.httpOpts.MaxPages {
+			s.client.parent.logger.Warn("pagination capped", "maxPages", s.client.parent.httpOpts.MaxPages)
+			return nil
+		}
+		nextURL := resolveURL(currentURL, nextLink)
+		if !isSameOrigin(baseURL, nextURL) {
+			return fmt.Errorf("pagination Link header points to different origin: %s", nextURL)
+		}
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+
+		pageResp, err := s.client.parent.doRequestURL(ctx, "GET", nextURL, nil)
+		if err != nil {
+			return err
+		}
+		var todos []generated.Todo
+		if err := json.Unmarshal(pageResp.Data, &todos); err != nil {
+			return fmt.Errorf("failed to parse todo: %w", err)
+		}
+		for _, gt := range todos {
+			if !emit(gt) {
+				return ctx.Err()
+			}
+		}
+
+		currentURL = nextURL
+		nextLink = parseNextLink(pageResp.Headers.Get("Link"))
+	}
+	return nil
 }
 
 // Get returns a todo by ID.
@@ -457,6 +767,7 @@ func (s *TodosService) Get(ctx context.Context, todoID int64) (result *Todo, err
 		Service: "Todos", Operation: "Get",
 		ResourceType: "todo", IsMutation: false,
 		ResourceID: todoID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -489,6 +800,7 @@ func (s *TodosService) Create(ctx context.Context, todolistID int64, req *Create
 	op := OperationInfo{
 		Service: "Todos", Operation: "Create",
 		ResourceType: "todo", IsMutation: true,
+		AccountID: s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -629,6 +941,51 @@ func (s *TodosService) Edit(ctx context.Context, todoID int64, fn func(*TodoFiel
 	return s.replaceTodo(ctx, todoID, fields.fullBody)
 }
 
+// ReassignBatch sets the assignees of many todos concurrently. assignments
+// maps each todo ID to its complete new list of assignee IDs; an empty list
+// clears the todo's assignees. Every other field is preserved: each todo is
+// an Edit, so hooks observe Todos.Get then Todos.Replace per todo.
+//
+// ReassignBatch attempts every todo even if some fail. If any fail, it returns
+// a *BulkError listing each failure by todo ID. Empty input returns nil.
+func (s *TodosService) ReassignBatch(ctx context.Context, assignments map[int64][]int64, opts *BatchOptions) error {
+	_, err := runBatch(slices.Collect(maps.Keys(assignments)), opts, func(todoID int64) error {
+		_, err := s.Edit(ctx, todoID, func(f *TodoFields) error {
+			f.AssigneeIDs = assignments[todoID]
+			return nil
+		})
+		return err
+	})
+	return err
+}
+
+// SetDueDate sets a todo's due date (YYYY-MM-DD), preserving every other
+// field. The todo endpoint replaces the whole representation on PUT, so this
+// is an Edit: hooks observe Todos.Get then Todos.Replace.
+func (s *TodosService) SetDueDate(ctx context.Context, todoID int64, dueOn string) error {
+	if dueOn == "" {
+		return ErrUsage("due date is required (use ClearDueDate to remove it)")
+	}
+	if _, err := types.ParseDate(dueOn); err != nil {
+		return ErrUsage("todo due_on must be in YYYY-MM-DD format")
+	}
+	_, err := s.Edit(ctx, todoID, func(f *TodoFields) error {
+		f.DueOn = dueOn
+		return nil
+	})
+	return err
+}
+
+// ClearDueDate removes a todo's due date, preserving every other field.
+// Like SetDueDate, it is an Edit (Todos.Get then Todos.Replace).
+func (s *TodosService) ClearDueDate(ctx context.Context, todoID int64) error {
+	_, err := s.Edit(ctx, todoID, func(f *TodoFields) error {
+		f.DueOn = ""
+		return nil
+	})
+	return err
+}
+
 // Replace sends the request verbatim as the todo's new complete
 // representation — the server's native PUT semantics. No GET is issued,
 // and any field omitted from the request is cleared server-side (empty or
@@ -682,6 +1039,7 @@ func (s *TodosService) replaceTodo(ctx context.Context, todoID int64, buildBody
 		Service: "Todos", Operation: "Replace",
 		ResourceType: "todo", IsMutation: true,
 		ResourceID: todoID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -724,6 +1082,7 @@ func (s *TodosService) Trash(ctx context.Context, todoID int64) (err error) {
 		Service: "Todos", Operation: "Trash",
 		ResourceType: "todo", IsMutation: true,
 		ResourceID: todoID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -747,6 +1106,7 @@ func (s *TodosService) Complete(ctx context.Context, todoID int64) (err error) {
 		Service: "Todos", Operation: "Complete",
 		ResourceType: "todo", IsMutation: true,
 		ResourceID: todoID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -770,6 +1130,7 @@ func (s *TodosService) Uncomplete(ctx context.Context, todoID int64) (err error)
 		Service: "Todos", Operation: "Uncomplete",
 		ResourceType: "todo", IsMutation: true,
 		ResourceID: todoID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -795,6 +1156,7 @@ func (s *TodosService) Reposition(ctx context.Context, todoID int64, position in
 		Service: "Todos", Operation: "Reposition",
 		ResourceType: "todo", IsMutation: true,
 		ResourceID: todoID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
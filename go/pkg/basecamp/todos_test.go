@@ -10,7 +10,11 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
+	"reflect"
+	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -1789,3 +1793,434 @@ func TestTodosService_RepositionWithParentID(t *testing.T) {
 		t.Errorf("expected parent_id 99999, got %v", receivedBody["parent_id"])
 	}
 }
+
+func TestTodosService_List_OperationAccountID(t *testing.T) {
+	fixture := loadTodosFixture(t, "list.json")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(fixture)
+	}))
+	t.Cleanup(server.Close)
+
+	cfg := DefaultConfig()
+	cfg.BaseURL = server.URL
+	hooks := &recordingHooks{}
+	client := NewClient(cfg, &StaticTokenProvider{Token: "test-token"}, WithHooks(hooks))
+
+	if _, err := client.ForAccount("99999").Todos().List(context.Background(), 1, nil); err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+
+	if len(hooks.opStartCalls) != 1 || len(hooks.opEndCalls) != 1 {
+		t.Fatalf("expected 1 operation start/end, got %d/%d", len(hooks.opStartCalls), len(hooks.opEndCalls))
+	}
+	for _, op := range []OperationInfo{hooks.opStartCalls[0], hooks.opEndCalls[0]} {
+		if op.AccountID != "99999" {
+			t.Errorf("AccountID = %q, want %q", op.AccountID, "99999")
+		}
+	}
+}
+
+// pagedTodosHandler serves pages of two todos each, linking every page to
+// the next up to lastPage, and counts the requests it receives.
+func pagedTodosHandler(lastPage int, calls *atomic.Int32) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		if page == 0 {
+			page = 1
+		}
+		if page < lastPage {
+			w.Header().Set("Link", fmt.Sprintf(`<%s?page=%d>; rel="next"`, r.URL.Path, page+1))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `[{"id":%d,"content":"a"},{"id":%d,"content":"b"}]`, page*10+1, page*10+2)
+	}
+}
+
+func TestTodosService_Iter(t *testing.T) {
+	var calls atomic.Int32
+	svc := testTodosServer(t, pagedTodosHandler(3, &calls))
+
+	var ids []int64
+	for res := range svc.Iter(context.Background(), 1, nil) {
+		todo, err := res.Unwrap()
+		if err != nil {
+			t.Fatalf("Iter() error = %v", err)
+		}
+		ids = append(ids, todo.ID)
+	}
+
+	want := []int64{11, 12, 21, 22, 31, 32}
+	if !reflect.DeepEqual(ids, want) {
+		t.Errorf("ids = %v, want %v", ids, want)
+	}
+	if calls.Load() != 3 {
+		t.Errorf("calls = %d, want 3", calls.Load())
+	}
+}
+
+func TestTodosService_Iter_Limit(t *testing.T) {
+	var calls atomic.Int32
+	svc := testTodosServer(t, pagedTodosHandler(10, &calls))
+
+	var n int
+	for res := range svc.Iter(context.Background(), 1, &TodoListOptions{Limit: 3}) {
+		if res.Err != nil {
+			t.Fatalf("Iter() error = %v", res.Err)
+		}
+		n++
+	}
+	if n != 3 {
+		t.Errorf("received %d todos, want 3", n)
+	}
+	if calls.Load() != 2 {
+		t.Errorf("calls = %d, want 2", calls.Load())
+	}
+}
+
+func TestTodosService_Iter_CancelStopsRequests(t *testing.T) {
+	var calls atomic.Int32
+	svc := testTodosServer(t, pagedTodosHandler(100, &calls))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ch := svc.Iter(ctx, 1, nil)
+	if res := <-ch; res.Err != nil {
+		t.Fatalf("first result error = %v", res.Err)
+	}
+	cancel()
+
+	timeout := time.After(time.Second)
+	for closed := false; !closed; {
+		select {
+		case res, ok := <-ch:
+			if !ok {
+				closed = true
+			} else if res.Err != nil {
+				t.Errorf("unexpected error result after cancel: %v", res.Err)
+			}
+		case <-timeout:
+			t.Fatal("channel not closed after cancellation")
+		}
+	}
+
+	if got := calls.Load(); got != 1 {
+		t.Errorf("calls = %d, want 1 (no requests after cancellation)", got)
+	}
+}
+
+func TestTodosService_Iter_Error(t *testing.T) {
+	var calls atomic.Int32
+	paged := pagedTodosHandler(3, &calls)
+	svc := testTodosServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "2" {
+			calls.Add(1)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		paged(w, r)
+	})
+
+	var todos int
+	var lastErr error
+	for res := range svc.Iter(context.Background(), 1, nil) {
+		if res.Err != nil {
+			lastErr = res.Err
+			continue
+		}
+		if lastErr != nil {
+			t.Fatal("received todo after error")
+		}
+		todos++
+	}
+	if todos != 2 {
+		t.Errorf("received %d todos, want 2", todos)
+	}
+	if !IsNotFound(lastErr) {
+		t.Errorf("error = %v, want not found", lastErr)
+	}
+}
+
+func TestTodosService_Iter_InvalidStatus(t *testing.T) {
+	var calls atomic.Int32
+	svc := testTodosServer(t, pagedTodosHandler(1, &calls))
+
+	res, ok := <-svc.Iter(context.Background(), 1, &TodoListOptions{Status: "bogus"})
+	if !ok || ErrorCode(res.Err) != CodeUsage {
+		t.Errorf("result = %+v, %v; want usage error", res, ok)
+	}
+	if calls.Load() != 0 {
+		t.Errorf("calls = %d, want 0", calls.Load())
+	}
+}
+
+func TestTodosService_SetDueDate(t *testing.T) {
+	fixture := loadTodosFixture(t, "get.json")
+	svc, reqs := testTodosCaptureServer(t, fixture, fixture, nil)
+
+	if err := svc.SetDueDate(context.Background(), 1069479520, "2026-03-01"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(*reqs) != 2 || (*reqs)[0].method != "GET" || (*reqs)[1].method != "PUT" {
+		t.Fatalf("expected GET then PUT, got %+v", *reqs)
+	}
+	put := (*reqs)[1]
+	if put.path != "/99999/todos/1069479520" {
+		t.Errorf("expected PUT /99999/todos/1069479520, got %s", put.path)
+	}
+	if put.body["due_on"] != "2026-03-01" {
+		t.Errorf("expected due_on 2026-03-01, got %v", put.body["due_on"])
+	}
+	// The todo PUT replaces the whole representation, so the other fields
+	// must be carried over from the GET rather than left out.
+	if put.body["content"] != "Program Leto locator  microcontroller unit" {
+		t.Errorf("expected preserved content, got %v", put.body["content"])
+	}
+	if _, ok := put.body["assignee_ids"]; !ok {
+		t.Error("expected assignee_ids carried over in PUT body")
+	}
+}
+
+func TestTodosService_ClearDueDate(t *testing.T) {
+	fixture := loadTodosFixture(t, "get.json")
+	svc, reqs := testTodosCaptureServer(t, fixture, fixture, nil)
+
+	if err := svc.ClearDueDate(context.Background(), 1069479520); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	body := (*reqs)[len(*reqs)-1].body
+	if _, ok := body["due_on"]; ok {
+		t.Errorf("expected due_on omitted from PUT body, got %v", body["due_on"])
+	}
+	if body["content"] != "Program Leto locator  microcontroller unit" {
+		t.Errorf("expected preserved content, got %v", body["content"])
+	}
+}
+
+func TestTodosService_SetDueDate_InvalidFormat(t *testing.T) {
+	fixture := loadTodosFixture(t, "get.json")
+	svc, reqs := testTodosCaptureServer(t, fixture, fixture, nil)
+
+	for _, dueOn := range []string{"", "tomorrow", "2026/03/01"} {
+		if err := svc.SetDueDate(context.Background(), 1069479520, dueOn); ErrorCode(err) != CodeUsage {
+			t.Errorf("SetDueDate(%q) error = %v, want usage error", dueOn, err)
+		}
+	}
+	if len(*reqs) != 0 {
+		t.Errorf("expected no requests, got %d", len(*reqs))
+	}
+}
+
+func TestTodoFilter(t *testing.T) {
+	now := time.Date(2024, 3, 15, 9, 0, 0, 0, time.UTC)
+	todos := []Todo{
+		{ID: 1, DueOn: "2024-03-01", StartsOn: "2024-02-20", Assignees: []Person{{ID: 7}}},
+		{ID: 2, DueOn: "2024-03-10", Completed: true, Assignees: []Person{{ID: 8}}},
+		{ID: 3, DueOn: "2024-03-20", StartsOn: "2024-03-18"},
+		{ID: 4, Assignees: []Person{{ID: 7}, {ID: 9}}},
+	}
+
+	tests := []struct {
+		name string
+		opts *TodoListOptions
+		want []int64
+	}{
+		{"no filters", &TodoListOptions{Status: "archived", Limit: 5}, []int64{1, 2, 3, 4}},
+		{"assignee", &TodoListOptions{AssigneeIDs: []int64{7}}, []int64{1, 4}},
+		{"any assignee", &TodoListOptions{AssigneeIDs: []int64{8, 9}}, []int64{2, 4}},
+		{"due before", &TodoListOptions{DueOnBefore: "2024-03-10"}, []int64{1}},
+		{"due after", &TodoListOptions{DueOnAfter: "2024-03-01"}, []int64{2, 3}},
+		{"due range", &TodoListOptions{DueOnAfter: "2024-03-01", DueOnBefore: "2024-03-20"}, []int64{2}},
+		{"starts before", &TodoListOptions{StartsOnBefore: "2024-03-01"}, []int64{1}},
+		{"starts after", &TodoListOptions{StartsOnAfter: "2024-03-01"}, []int64{3}},
+		{"overdue uses today", &TodoListOptions{Overdue: true}, []int64{1}},
+		{"overdue with explicit bound", &TodoListOptions{Overdue: true, DueOnBefore: "2024-03-25"}, []int64{1, 3}},
+		{"overdue and assignee", &TodoListOptions{Overdue: true, AssigneeIDs: []int64{8}}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, err := newTodoFilter(tt.opts, now)
+			if err != nil {
+				t.Fatalf("newTodoFilter: %v", err)
+			}
+			var got []int64
+			for _, todo := range filter.apply(append([]Todo(nil), todos...)) {
+				got = append(got, todo.ID)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("matched %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTodoFilter_NoFiltersIsNil(t *testing.T) {
+	for _, opts := range []*TodoListOptions{nil, {}, {Status: "trashed", Completed: true, Limit: -1}} {
+		filter, err := newTodoFilter(opts, time.Now())
+		if err != nil || filter != nil {
+			t.Errorf("newTodoFilter(%+v) = %+v, %v; want nil, nil", opts, filter, err)
+		}
+	}
+}
+
+func TestTodoFilter_InvalidDate(t *testing.T) {
+	for _, opts := range []*TodoListOptions{
+		{DueOnBefore: "03/01/2024"},
+		{DueOnAfter: "2024-3-1"},
+		{StartsOnBefore: "tomorrow"},
+		{StartsOnAfter: "2024-02-30"},
+	} {
+		if _, err := newTodoFilter(opts, time.Now()); ErrorCode(err) != CodeUsage {
+			t.Errorf("newTodoFilter(%+v) error = %v, want usage error", opts, err)
+		}
+	}
+}
+
+func TestTodosService_List_ClientSideFilters(t *testing.T) {
+	var queries []url.Values
+	svc := testTodosServer(t, func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.Query())
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("page") == "2" {
+			_, _ = w.Write([]byte(`[{"id":3,"due_on":"2000-01-03","assignees":[{"id":7}]},{"id":4,"due_on":"2000-01-04","assignees":[{"id":7}]}]`))
+			return
+		}
+		w.Header().Set("Link", fmt.Sprintf(`<http://%s/99999/todolists/1/todos.json?page=2>; rel="next"`, r.Host))
+		_, _ = w.Write([]byte(`[{"id":1,"due_on":"2000-01-01","assignees":[{"id":7}]},{"id":2,"due_on":"2000-01-02","assignees":[{"id":8}]}]`))
+	})
+
+	result, err := svc.List(context.Background(), 1, &TodoListOptions{
+		AssigneeIDs: []int64{7},
+		Overdue:     true,
+		Limit:       2,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(queries) != 2 {
+		t.Fatalf("expected both pages to be fetched, got %d requests", len(queries))
+	}
+	for _, key := range []string{"assignee_ids", "due_on_before", "overdue"} {
+		if queries[0].Has(key) {
+			t.Errorf("expected no %s query parameter, got %q", key, queries[0].Get(key))
+		}
+	}
+	if len(result.Todos) != 2 || result.Todos[0].ID != 1 || result.Todos[1].ID != 3 {
+		t.Errorf("expected todos 1 and 3, got %+v", result.Todos)
+	}
+	if !result.Meta.Truncated {
+		t.Error("expected Truncated when matches exceed Limit")
+	}
+}
+
+// testReassignServer serves todo GETs and PUTs, failing GETs for the todo IDs
+// in missing with 404. It records each PUT's assignee_ids and the peak number
+// of requests in flight.
+type testReassignServer struct {
+	mu        sync.Mutex
+	assigned  map[int64][]any
+	inFlight  atomic.Int32
+	maxFlight atomic.Int32
+	missing   map[int64]bool
+}
+
+func (rs *testReassignServer) handle(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		n := rs.inFlight.Add(1)
+		defer rs.inFlight.Add(-1)
+		for {
+			peak := rs.maxFlight.Load()
+			if n <= peak || rs.maxFlight.CompareAndSwap(peak, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+
+		idStr := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/99999/todos/"), ".json")
+		id, err := strconv.ParseInt(idStr, 10, 64)
+		if err != nil {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if rs.missing[id] {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Method == http.MethodPut {
+			body := decodeRequestBody(t, r)
+			rs.mu.Lock()
+			rs.assigned[id], _ = body["assignee_ids"].([]any)
+			rs.mu.Unlock()
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"id":%d,"content":"Todo %d","description":"keep me"}`, id, id)
+	}
+}
+
+func TestTodosService_ReassignBatch(t *testing.T) {
+	rs := &testReassignServer{assigned: map[int64][]any{}}
+	svc := testTodosServer(t, rs.handle(t))
+
+	assignments := map[int64][]int64{}
+	for id := int64(1); id <= 6; id++ {
+		assignments[id] = []int64{100 + id}
+	}
+	assignments[7] = []int64{}
+
+	if err := svc.ReassignBatch(context.Background(), assignments, &BatchOptions{Concurrency: 3}); err != nil {
+		t.Fatalf("ReassignBatch: %v", err)
+	}
+	if len(rs.assigned) != 7 {
+		t.Fatalf("expected 7 PUTs, got %d", len(rs.assigned))
+	}
+	for id := int64(1); id <= 6; id++ {
+		if got := rs.assigned[id]; len(got) != 1 || fmt.Sprint(got[0]) != strconv.FormatInt(100+id, 10) {
+			t.Errorf("todo %d assignee_ids = %v, want [%d]", id, got, 100+id)
+		}
+	}
+	if got := rs.assigned[7]; got == nil || len(got) != 0 {
+		t.Errorf("todo 7 assignee_ids = %v, want []", got)
+	}
+	if peak := rs.maxFlight.Load(); peak > 3 || peak < 2 {
+		t.Errorf("peak concurrent requests = %d, want 2-3", peak)
+	}
+}
+
+func TestTodosService_ReassignBatch_PartialFailure(t *testing.T) {
+	rs := &testReassignServer{assigned: map[int64][]any{}, missing: map[int64]bool{2: true, 4: true}}
+	svc := testTodosServer(t, rs.handle(t))
+
+	err := svc.ReassignBatch(context.Background(), map[int64][]int64{
+		1: {10}, 2: {20}, 3: {30}, 4: {40},
+	}, nil)
+
+	var bulkErr *BulkError
+	if !errors.As(err, &bulkErr) {
+		t.Fatalf("expected *BulkError, got %v", err)
+	}
+	if len(bulkErr.Errors) != 2 || bulkErr.Errors[0].ResourceID != 2 || bulkErr.Errors[1].ResourceID != 4 {
+		t.Fatalf("bulk errors = %+v, want failures for todos 2 and 4", bulkErr.Errors)
+	}
+	if !IsNotFound(err) {
+		t.Errorf("expected IsNotFound to see through BulkError, got %v", err)
+	}
+	if len(rs.assigned) != 2 || rs.assigned[1] == nil || rs.assigned[3] == nil {
+		t.Errorf("assigned = %v, want todos 1 and 3 updated", rs.assigned)
+	}
+}
+
+func TestTodosService_ReassignBatch_Empty(t *testing.T) {
+	svc := testTodosServer(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+	})
+	if err := svc.ReassignBatch(context.Background(), nil, nil); err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+}
@@ -54,6 +54,7 @@ func (s *TodosetsService) Get(ctx context.Context, todosetID int64) (result *Tod
 		Service: "Todosets", Operation: "Get",
 		ResourceType: "todoset", IsMutation: false,
 		ResourceID: todosetID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -80,6 +81,23 @@ func (s *TodosetsService) Get(ctx context.Context, todosetID int64) (result *Tod
 	return &todoset, nil
 }
 
+// GetForProject returns the todoset in a project's dock. It fetches the
+// project, finds the dock tool named "todoset", and fetches that todoset, so
+// hooks observe Projects.Get then Todosets.Get. It returns a not-found error
+// if the project's dock has no todoset.
+func (s *TodosetsService) GetForProject(ctx context.Context, projectID int64) (*Todoset, error) {
+	project, err := s.client.Projects().Get(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	for _, tool := range project.Dock {
+		if tool.Name == "todoset" {
+			return s.Get(ctx, tool.ID)
+		}
+	}
+	return nil, ErrNotFound("Todoset", fmt.Sprintf("in project %d", projectID))
+}
+
 // todosetFromGenerated converts a generated Todoset to our clean Todoset type.
 func todosetFromGenerated(gts generated.Todoset) Todoset {
 	ts := Todoset{
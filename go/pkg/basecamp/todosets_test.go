@@ -1,7 +1,10 @@
 package basecamp
 
 import (
+	"context"
 	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"testing"
@@ -82,3 +85,65 @@ func TestTodoset_TimestampParsing(t *testing.T) {
 		t.Errorf("expected year 2022, got %d", todoset.CreatedAt.Year())
 	}
 }
+
+func testTodosetsServer(t *testing.T, handler http.HandlerFunc) *TodosetsService {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	cfg := DefaultConfig()
+	cfg.BaseURL = server.URL
+	client := NewClient(cfg, &StaticTokenProvider{Token: "test-token"})
+	return client.ForAccount("99999").Todosets()
+}
+
+func TestTodosetsService_GetForProject(t *testing.T) {
+	project, err := os.ReadFile(filepath.Join("..", "..", "..", "spec", "fixtures", "projects", "get.json"))
+	if err != nil {
+		t.Fatalf("failed to read project fixture: %v", err)
+	}
+	todoset := loadTodosetsFixture(t, "get.json")
+
+	var paths []string
+	svc := testTodosetsServer(t, func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/99999/projects/2085958499":
+			w.Write(project)
+		case "/99999/todosets/1069479339":
+			w.Write(todoset)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	ts, err := svc.GetForProject(context.Background(), 2085958499)
+	if err != nil {
+		t.Fatalf("GetForProject() error = %v", err)
+	}
+	want := []string{"/99999/projects/2085958499", "/99999/todosets/1069479339"}
+	if len(paths) != 2 || paths[0] != want[0] || paths[1] != want[1] {
+		t.Errorf("paths = %v, want %v", paths, want)
+	}
+
+	var expected Todoset
+	if err := json.Unmarshal(todoset, &expected); err != nil {
+		t.Fatalf("failed to unmarshal todoset fixture: %v", err)
+	}
+	if ts.ID != expected.ID || ts.Title != expected.Title || ts.TodolistsURL != expected.TodolistsURL {
+		t.Errorf("todoset = %+v, want fixture values", ts)
+	}
+}
+
+func TestTodosetsService_GetForProject_NoTodoset(t *testing.T) {
+	svc := testTodosetsServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":1,"name":"Empty","dock":[{"id":2,"name":"vault","enabled":true}]}`))
+	})
+
+	_, err := svc.GetForProject(context.Background(), 1)
+	if !IsNotFound(err) {
+		t.Fatalf("expected not-found error, got %v", err)
+	}
+}
@@ -51,6 +51,7 @@ func (s *ToolsService) Get(ctx context.Context, toolID int64) (result *Tool, err
 		Service: "Tools", Operation: "Get",
 		ResourceType: "tool", IsMutation: false,
 		ResourceID: toolID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -88,6 +89,7 @@ func (s *ToolsService) Create(ctx context.Context, bucketID int64, toolType stri
 		Service: "Tools", Operation: "Create",
 		ResourceType: "tool", IsMutation: true,
 		ResourceID: bucketID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -133,6 +135,7 @@ func (s *ToolsService) Update(ctx context.Context, toolID int64, title string) (
 		Service: "Tools", Operation: "Update",
 		ResourceType: "tool", IsMutation: true,
 		ResourceID: toolID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -175,6 +178,7 @@ func (s *ToolsService) Delete(ctx context.Context, toolID int64) (err error) {
 		Service: "Tools", Operation: "Delete",
 		ResourceType: "tool", IsMutation: true,
 		ResourceID: toolID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -199,6 +203,7 @@ func (s *ToolsService) Enable(ctx context.Context, toolID int64) (err error) {
 		Service: "Tools", Operation: "Enable",
 		ResourceType: "tool", IsMutation: true,
 		ResourceID: toolID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -223,6 +228,7 @@ func (s *ToolsService) Disable(ctx context.Context, toolID int64) (err error) {
 		Service: "Tools", Operation: "Disable",
 		ResourceType: "tool", IsMutation: true,
 		ResourceID: toolID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -247,6 +253,7 @@ func (s *ToolsService) Reposition(ctx context.Context, toolID int64, position in
 		Service: "Tools", Operation: "Reposition",
 		ResourceType: "tool", IsMutation: true,
 		ResourceID: toolID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -3,8 +3,10 @@ package basecamp
 import (
 	_ "embed"
 	"encoding/json"
+	"net/url"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 )
@@ -251,6 +253,49 @@ func (r *Router) MatchStructural(rawURL string) *Match {
 	return matchStructural(path, fragment)
 }
 
+// ParseBasecampURL extracts the account ID and project ID from a Basecamp
+// app or API URL, such as https://3.basecamp.com/12345/projects/67890 or
+// https://3.basecamp.com/12345/buckets/67890/todos/1. The host must be
+// basecamp.com, basecampapi.com, or a subdomain of either.
+//
+// It returns a usage error if the URL is malformed, on another host, or has
+// no account or project ID (e.g., an account-level URL like
+// https://3.basecamp.com/12345/projects).
+func ParseBasecampURL(rawURL string) (accountID string, projectID int64, err error) {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		return "", 0, ErrUsage("not an absolute Basecamp URL: " + rawURL)
+	}
+	host := strings.ToLower(u.Hostname())
+	if !isBasecampHost(host) {
+		return "", 0, ErrUsage("not a Basecamp host: " + host)
+	}
+
+	m := DefaultRouter().Match(rawURL)
+	if m == nil || !isNumeric(m.AccountID) {
+		return "", 0, ErrUsage("URL has no account ID: " + rawURL)
+	}
+	if m.ProjectID == "" {
+		return "", 0, ErrUsage("URL has no project ID: " + rawURL)
+	}
+	projectID, err = strconv.ParseInt(m.ProjectID, 10, 64)
+	if err != nil {
+		return "", 0, ErrUsage("invalid project ID in URL: " + rawURL)
+	}
+	return m.AccountID, projectID, nil
+}
+
+// isBasecampHost reports whether host is basecamp.com, basecampapi.com, or a
+// subdomain of either.
+func isBasecampHost(host string) bool {
+	for _, domain := range []string{"basecamp.com", "basecampapi.com"} {
+		if host == domain || strings.HasSuffix(host, "."+domain) {
+			return true
+		}
+	}
+	return false
+}
+
 // preprocessURL validates and parses a raw URL into path and fragment components.
 // Returns (path, fragment, ok). Returns ok=false if the URL doesn't look like a Basecamp URL.
 func preprocessURL(rawURL string) (path, fragment string, ok bool) {
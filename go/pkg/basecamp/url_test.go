@@ -1,6 +1,7 @@
 package basecamp
 
 import (
+	"errors"
 	"testing"
 )
 
@@ -430,3 +431,58 @@ func TestResourceIDNil(t *testing.T) {
 		t.Errorf("nil Match.ResourceID() = %q, want empty", m.ResourceID())
 	}
 }
+
+func TestParseBasecampURL(t *testing.T) {
+	tests := []struct {
+		name        string
+		url         string
+		wantAccount string
+		wantProject int64
+	}{
+		{"project URL", "https://3.basecamp.com/12345/projects/67890", "12345", 67890},
+		{"project URL with trailing slash", "https://3.basecamp.com/12345/projects/67890/", "12345", 67890},
+		{"recording in project", "https://3.basecamp.com/12345/buckets/67890/todos/111", "12345", 67890},
+		{"comment fragment", "https://3.basecamp.com/12345/buckets/67890/messages/111#__recording_222", "12345", 67890},
+		{"API URL", "https://3.basecampapi.com/12345/projects/67890.json", "12345", 67890},
+		{"bare domain", "https://basecamp.com/12345/projects/67890", "12345", 67890},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			accountID, projectID, err := ParseBasecampURL(tt.url)
+			if err != nil {
+				t.Fatalf("ParseBasecampURL(%q) error = %v", tt.url, err)
+			}
+			if accountID != tt.wantAccount || projectID != tt.wantProject {
+				t.Errorf("ParseBasecampURL(%q) = (%q, %d), want (%q, %d)", tt.url, accountID, projectID, tt.wantAccount, tt.wantProject)
+			}
+		})
+	}
+}
+
+func TestParseBasecampURL_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		url  string
+	}{
+		{"other host", "https://example.com/12345/projects/67890"},
+		{"lookalike host", "https://evilbasecamp.com/12345/projects/67890"},
+		{"relative URL", "/12345/projects/67890"},
+		{"non-HTTP scheme", "ftp://3.basecamp.com/12345/projects/67890"},
+		{"no path", "https://3.basecamp.com"},
+		{"non-numeric account", "https://3.basecamp.com/acme/projects/67890"},
+		{"account-level URL", "https://3.basecamp.com/12345"},
+		{"projects index", "https://3.basecamp.com/12345/projects"},
+		{"account-level resource", "https://3.basecamp.com/12345/my/assignments"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := ParseBasecampURL(tt.url)
+			if err == nil {
+				t.Fatalf("ParseBasecampURL(%q) expected error", tt.url)
+			}
+			if apiErr, ok := errors.AsType[*Error](err); !ok || apiErr.Code != CodeUsage {
+				t.Errorf("ParseBasecampURL(%q) error = %v, want usage error", tt.url, err)
+			}
+		})
+	}
+}
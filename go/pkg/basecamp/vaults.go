@@ -5,8 +5,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"os"
+	"path/filepath"
 	"time"
 
+	"golang.org/x/sync/errgroup"
+
 	"github.com/basecamp/basecamp-sdk/go/pkg/generated"
 )
 
@@ -30,6 +34,26 @@ type VaultListResult struct {
 	Meta ListMeta
 }
 
+// RecursiveVaultOptions specifies options for ListRecursive.
+type RecursiveVaultOptions struct {
+	// MaxDepth is how many levels of sub-vaults below the root to descend.
+	// Vaults at MaxDepth are included with their documents and uploads, but
+	// their sub-vaults are not listed. If 0 (default), the whole tree is walked.
+	MaxDepth int
+
+	// Concurrency caps the number of list requests in flight at once.
+	// If 0 (default), all requests for a level are made at once.
+	Concurrency int
+}
+
+// VaultTree is a vault with its contents, as returned by ListRecursive.
+type VaultTree struct {
+	Vault     *Vault
+	Children  []*VaultTree
+	Documents []Document
+	Uploads   []Upload
+}
+
 // DocumentListOptions specifies options for listing documents.
 type DocumentListOptions struct {
 	// Limit is the maximum number of documents to return.
@@ -40,6 +64,11 @@ type DocumentListOptions struct {
 	// NOTE: The page number itself is not yet honored due to OpenAPI client
 	// limitations. Use 0 to paginate through all results up to Limit.
 	Page int
+
+	// CreatorID, if set, returns only documents created by that person.
+	// The endpoint has no creator filter, so every page is fetched and
+	// filtered client-side; Limit then caps the filtered documents.
+	CreatorID *int64
 }
 
 // DocumentListResult contains the results from listing documents.
@@ -236,6 +265,7 @@ func (s *VaultsService) Get(ctx context.Context, vaultID int64) (result *Vault,
 		Service: "Vaults", Operation: "Get",
 		ResourceType: "vault", IsMutation: false,
 		ResourceID: vaultID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -271,12 +301,13 @@ func (s *VaultsService) Get(ctx context.Context, vaultID int64) (result *Vault,
 //   - Page: if positive, disables pagination and returns first page only
 //
 // The returned VaultListResult includes pagination metadata (TotalCount from
-// X-Total-Count header) when available.
+// the X-Total-Count header, and TotalPages derived from it) when available.
 func (s *VaultsService) List(ctx context.Context, vaultID int64, opts *VaultListOptions) (result *VaultListResult, err error) {
 	op := OperationInfo{
 		Service: "Vaults", Operation: "List",
 		ResourceType: "vault", IsMutation: false,
 		ResourceID: vaultID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -298,6 +329,7 @@ func (s *VaultsService) List(ctx context.Context, vaultID int64, opts *VaultList
 
 	// Capture total count from X-Total-Count header (first page only)
 	totalCount := parseTotalCount(resp.HTTPResponse)
+	totalPages := parseTotalPages(resp.HTTPResponse, firstPageLen(resp.JSON200))
 
 	// Parse first page
 	var vaults []Vault
@@ -309,7 +341,7 @@ func (s *VaultsService) List(ctx context.Context, vaultID int64, opts *VaultList
 
 	// Handle single page fetch (--page flag)
 	if opts != nil && opts.Page > 0 {
-		return &VaultListResult{Vaults: vaults, Meta: ListMeta{TotalCount: totalCount}}, nil
+		return &VaultListResult{Vaults: vaults, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages}}, nil
 	}
 
 	// Determine limit: 0 = all (default for vaults), >0 = specific limit
@@ -320,7 +352,7 @@ func (s *VaultsService) List(ctx context.Context, vaultID int64, opts *VaultList
 
 	// Check if we already have enough items
 	if limit > 0 && len(vaults) >= limit {
-		return &VaultListResult{Vaults: vaults[:limit], Meta: ListMeta{TotalCount: totalCount, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(vaults), limit)}}, nil
+		return &VaultListResult{Vaults: vaults[:limit], Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(vaults), limit)}}, nil
 	}
 
 	// Follow pagination via Link headers (uses absolute URLs from API, no path construction)
@@ -338,7 +370,81 @@ func (s *VaultsService) List(ctx context.Context, vaultID int64, opts *VaultList
 		vaults = append(vaults, vaultFromGenerated(gv))
 	}
 
-	return &VaultListResult{Vaults: vaults, Meta: ListMeta{TotalCount: totalCount, Truncated: truncated}}, nil
+	return &VaultListResult{Vaults: vaults, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: truncated}}, nil
+}
+
+// ListRecursive returns the vault tree rooted at rootVaultID: every sub-vault
+// with its documents and uploads. The tree is walked breadth-first, one level
+// at a time, with at most opts.Concurrency list requests in flight.
+//
+// If any request fails, the remaining requests are canceled and the error is
+// returned with the ID of the vault being listed; no partial tree is returned.
+// ListRecursive is built on Get and the List methods of Vaults, Documents and
+// Uploads, so hooks observe those operations.
+func (s *VaultsService) ListRecursive(ctx context.Context, rootVaultID int64, opts *RecursiveVaultOptions) (*VaultTree, error) {
+	if opts == nil {
+		opts = &RecursiveVaultOptions{}
+	}
+
+	root, err := s.Get(ctx, rootVaultID)
+	if err != nil {
+		return nil, fmt.Errorf("vault %d: %w", rootVaultID, err)
+	}
+	tree := &VaultTree{Vault: root}
+
+	documents := s.client.Documents()
+	uploads := s.client.Uploads()
+	level := []*VaultTree{tree}
+	for depth := 0; len(level) > 0; depth++ {
+		descend := opts.MaxDepth <= 0 || depth < opts.MaxDepth
+
+		g, gctx := errgroup.WithContext(ctx)
+		if opts.Concurrency > 0 {
+			g.SetLimit(opts.Concurrency)
+		}
+		for _, node := range level {
+			id := node.Vault.ID
+			g.Go(func() error {
+				result, err := documents.List(gctx, id, nil)
+				if err != nil {
+					return fmt.Errorf("vault %d: %w", id, err)
+				}
+				node.Documents = result.Documents
+				return nil
+			})
+			g.Go(func() error {
+				result, err := uploads.List(gctx, id, nil)
+				if err != nil {
+					return fmt.Errorf("vault %d: %w", id, err)
+				}
+				node.Uploads = result.Uploads
+				return nil
+			})
+			if descend {
+				g.Go(func() error {
+					result, err := s.List(gctx, id, nil)
+					if err != nil {
+						return fmt.Errorf("vault %d: %w", id, err)
+					}
+					for i := range result.Vaults {
+						node.Children = append(node.Children, &VaultTree{Vault: &result.Vaults[i]})
+					}
+					return nil
+				})
+			}
+		}
+		if err := g.Wait(); err != nil {
+			return nil, err
+		}
+
+		var next []*VaultTree
+		for _, node := range level {
+			next = append(next, node.Children...)
+		}
+		level = next
+	}
+
+	return tree, nil
 }
 
 // Create creates a new subfolder (child vault) in a vault.
@@ -348,6 +454,7 @@ func (s *VaultsService) Create(ctx context.Context, vaultID int64, req *CreateVa
 		Service: "Vaults", Operation: "Create",
 		ResourceType: "vault", IsMutation: true,
 		ResourceID: vaultID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -390,6 +497,7 @@ func (s *VaultsService) Update(ctx context.Context, vaultID int64, req *UpdateVa
 		Service: "Vaults", Operation: "Update",
 		ResourceType: "vault", IsMutation: true,
 		ResourceID: vaultID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -442,6 +550,7 @@ func (s *DocumentsService) Get(ctx context.Context, documentID int64) (result *D
 		Service: "Documents", Operation: "Get",
 		ResourceType: "document", IsMutation: false,
 		ResourceID: documentID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -476,13 +585,16 @@ func (s *DocumentsService) Get(ctx context.Context, documentID int64) (result *D
 //   - Limit: maximum number of documents to return (0 = all)
 //   - Page: if positive, disables pagination and returns first page only
 //
+// CreatorID filters the documents to one author, client-side.
+//
 // The returned DocumentListResult includes pagination metadata (TotalCount from
-// X-Total-Count header) when available.
+// the X-Total-Count header, and TotalPages derived from it) when available.
 func (s *DocumentsService) List(ctx context.Context, vaultID int64, opts *DocumentListOptions) (result *DocumentListResult, err error) {
 	op := OperationInfo{
 		Service: "Documents", Operation: "List",
 		ResourceType: "document", IsMutation: false,
 		ResourceID: vaultID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -504,6 +616,7 @@ func (s *DocumentsService) List(ctx context.Context, vaultID int64, opts *Docume
 
 	// Capture total count from X-Total-Count header (first page only)
 	totalCount := parseTotalCount(resp.HTTPResponse)
+	totalPages := parseTotalPages(resp.HTTPResponse, firstPageLen(resp.JSON200))
 
 	// Parse first page
 	var documents []Document
@@ -513,9 +626,15 @@ func (s *DocumentsService) List(ctx context.Context, vaultID int64, opts *Docume
 		}
 	}
 
+	// The creator filter is applied after fetching.
+	byCreator := opts != nil && opts.CreatorID != nil
+
 	// Handle single page fetch (--page flag)
 	if opts != nil && opts.Page > 0 {
-		return &DocumentListResult{Documents: documents, Meta: ListMeta{TotalCount: totalCount}}, nil
+		if byCreator {
+			documents = documentsByCreator(documents, *opts.CreatorID)
+		}
+		return &DocumentListResult{Documents: documents, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages}}, nil
 	}
 
 	// Determine limit: 0 = all (default for documents), >0 = specific limit
@@ -525,12 +644,18 @@ func (s *DocumentsService) List(ctx context.Context, vaultID int64, opts *Docume
 	}
 
 	// Check if we already have enough items
-	if limit > 0 && len(documents) >= limit {
-		return &DocumentListResult{Documents: documents[:limit], Meta: ListMeta{TotalCount: totalCount, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(documents), limit)}}, nil
+	if !byCreator && limit > 0 && len(documents) >= limit {
+		return &DocumentListResult{Documents: documents[:limit], Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(documents), limit)}}, nil
 	}
 
-	// Follow pagination via Link headers (uses absolute URLs from API, no path construction)
-	rawMore, truncated, err := s.client.parent.followPagination(ctx, resp.HTTPResponse, len(documents), limit)
+	// Follow pagination via Link headers (uses absolute URLs from API, no path construction).
+	// A creator filter is applied after fetching, so fetch every page and cap
+	// the filtered documents instead.
+	fetchLimit := limit
+	if byCreator {
+		fetchLimit = 0
+	}
+	rawMore, truncated, err := s.client.parent.followPagination(ctx, resp.HTTPResponse, len(documents), fetchLimit)
 	if err != nil {
 		return nil, err
 	}
@@ -544,7 +669,41 @@ func (s *DocumentsService) List(ctx context.Context, vaultID int64, opts *Docume
 		documents = append(documents, documentFromGenerated(gd))
 	}
 
-	return &DocumentListResult{Documents: documents, Meta: ListMeta{TotalCount: totalCount, Truncated: truncated}}, nil
+	if byCreator {
+		documents = documentsByCreator(documents, *opts.CreatorID)
+		if limit > 0 && len(documents) > limit {
+			documents = documents[:limit]
+			truncated = true
+		}
+	}
+
+	return &DocumentListResult{Documents: documents, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: truncated}}, nil
+}
+
+// documentsByCreator returns the documents created by personID, in order.
+func documentsByCreator(documents []Document, personID int64) []Document {
+	var kept []Document
+	for _, d := range documents {
+		if d.Creator != nil && d.Creator.ID == personID {
+			kept = append(kept, d)
+		}
+	}
+	return kept
+}
+
+// ListAll returns every document in a vault, following Link headers across
+// pages (subject to the client's MaxPages cap). opts may set CreatorID; Limit
+// and Page are ignored. Hooks observe the Documents.List operation.
+func (s *DocumentsService) ListAll(ctx context.Context, vaultID int64, opts *DocumentListOptions) ([]Document, error) {
+	all := &DocumentListOptions{}
+	if opts != nil {
+		all.CreatorID = opts.CreatorID
+	}
+	result, err := s.List(ctx, vaultID, all)
+	if err != nil {
+		return nil, err
+	}
+	return result.Documents, nil
 }
 
 // Create creates a new document in a vault.
@@ -554,6 +713,7 @@ func (s *DocumentsService) Create(ctx context.Context, vaultID int64, req *Creat
 		Service: "Documents", Operation: "Create",
 		ResourceType: "document", IsMutation: true,
 		ResourceID: vaultID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -599,6 +759,7 @@ func (s *DocumentsService) Update(ctx context.Context, documentID int64, req *Up
 		Service: "Documents", Operation: "Update",
 		ResourceType: "document", IsMutation: true,
 		ResourceID: documentID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -638,6 +799,23 @@ func (s *DocumentsService) Update(ctx context.Context, documentID int64, req *Up
 	return &document, nil
 }
 
+// Copy creates a new document in dstVaultID with the title and content of
+// the document srcDocumentID, which may be in another project.
+// Returns the new document.
+//
+// Hooks observe the two wire operations (Documents.Get then
+// Documents.Create), not a synthetic composite.
+func (s *DocumentsService) Copy(ctx context.Context, srcDocumentID, dstVaultID int64) (*Document, error) {
+	src, err := s.Get(ctx, srcDocumentID)
+	if err != nil {
+		return nil, err
+	}
+	return s.Create(ctx, dstVaultID, &CreateDocumentRequest{
+		Title:   src.Title,
+		Content: src.Content,
+	})
+}
+
 // Trash moves a document to the trash.
 // Trashed documents can be recovered from the trash.
 func (s *DocumentsService) Trash(ctx context.Context, documentID int64) (err error) {
@@ -645,6 +823,7 @@ func (s *DocumentsService) Trash(ctx context.Context, documentID int64) (err err
 		Service: "Documents", Operation: "Trash",
 		ResourceType: "document", IsMutation: true,
 		ResourceID: documentID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -678,6 +857,7 @@ func (s *UploadsService) Get(ctx context.Context, uploadID int64) (result *Uploa
 		Service: "Uploads", Operation: "Get",
 		ResourceType: "upload", IsMutation: false,
 		ResourceID: uploadID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -713,12 +893,13 @@ func (s *UploadsService) Get(ctx context.Context, uploadID int64) (result *Uploa
 //   - Page: if positive, disables pagination and returns first page only
 //
 // The returned UploadListResult includes pagination metadata (TotalCount from
-// X-Total-Count header) when available.
+// the X-Total-Count header, and TotalPages derived from it) when available.
 func (s *UploadsService) List(ctx context.Context, vaultID int64, opts *UploadListOptions) (result *UploadListResult, err error) {
 	op := OperationInfo{
 		Service: "Uploads", Operation: "List",
 		ResourceType: "upload", IsMutation: false,
 		ResourceID: vaultID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -740,6 +921,7 @@ func (s *UploadsService) List(ctx context.Context, vaultID int64, opts *UploadLi
 
 	// Capture total count from X-Total-Count header (first page only)
 	totalCount := parseTotalCount(resp.HTTPResponse)
+	totalPages := parseTotalPages(resp.HTTPResponse, firstPageLen(resp.JSON200))
 
 	// Parse first page
 	var uploads []Upload
@@ -751,7 +933,7 @@ func (s *UploadsService) List(ctx context.Context, vaultID int64, opts *UploadLi
 
 	// Handle single page fetch (--page flag)
 	if opts != nil && opts.Page > 0 {
-		return &UploadListResult{Uploads: uploads, Meta: ListMeta{TotalCount: totalCount}}, nil
+		return &UploadListResult{Uploads: uploads, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages}}, nil
 	}
 
 	// Determine limit: 0 = all (default for uploads), >0 = specific limit
@@ -762,7 +944,7 @@ func (s *UploadsService) List(ctx context.Context, vaultID int64, opts *UploadLi
 
 	// Check if we already have enough items
 	if limit > 0 && len(uploads) >= limit {
-		return &UploadListResult{Uploads: uploads[:limit], Meta: ListMeta{TotalCount: totalCount, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(uploads), limit)}}, nil
+		return &UploadListResult{Uploads: uploads[:limit], Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(uploads), limit)}}, nil
 	}
 
 	// Follow pagination via Link headers (uses absolute URLs from API, no path construction)
@@ -780,7 +962,7 @@ func (s *UploadsService) List(ctx context.Context, vaultID int64, opts *UploadLi
 		uploads = append(uploads, uploadFromGenerated(gu))
 	}
 
-	return &UploadListResult{Uploads: uploads, Meta: ListMeta{TotalCount: totalCount, Truncated: truncated}}, nil
+	return &UploadListResult{Uploads: uploads, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: truncated}}, nil
 }
 
 // Update updates an existing upload.
@@ -790,6 +972,7 @@ func (s *UploadsService) Update(ctx context.Context, uploadID int64, req *Update
 		Service: "Uploads", Operation: "Update",
 		ResourceType: "upload", IsMutation: true,
 		ResourceID: uploadID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -837,6 +1020,7 @@ func (s *UploadsService) Create(ctx context.Context, vaultID int64, req *CreateU
 		Service: "Uploads", Operation: "Create",
 		ResourceType: "upload", IsMutation: true,
 		ResourceID: vaultID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -882,6 +1066,7 @@ func (s *UploadsService) Trash(ctx context.Context, uploadID int64) (err error)
 		Service: "Uploads", Operation: "Trash",
 		ResourceType: "upload", IsMutation: true,
 		ResourceID: uploadID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -924,12 +1109,13 @@ type UploadVersionListResult struct {
 //   - Page: if positive, disables pagination and returns first page only
 //
 // The returned UploadVersionListResult includes pagination metadata (TotalCount from
-// X-Total-Count header) when available.
+// the X-Total-Count header, and TotalPages derived from it) when available.
 func (s *UploadsService) ListVersions(ctx context.Context, uploadID int64, opts *UploadVersionListOptions) (result *UploadVersionListResult, err error) {
 	op := OperationInfo{
 		Service: "Uploads", Operation: "ListVersions",
 		ResourceType: "upload", IsMutation: false,
 		ResourceID: uploadID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -951,6 +1137,7 @@ func (s *UploadsService) ListVersions(ctx context.Context, uploadID int64, opts
 
 	// Capture total count from X-Total-Count header (first page only)
 	totalCount := parseTotalCount(resp.HTTPResponse)
+	totalPages := parseTotalPages(resp.HTTPResponse, firstPageLen(resp.JSON200))
 
 	// Parse first page
 	var versions []Upload
@@ -962,7 +1149,7 @@ func (s *UploadsService) ListVersions(ctx context.Context, uploadID int64, opts
 
 	// Handle single page fetch (--page flag)
 	if opts != nil && opts.Page > 0 {
-		return &UploadVersionListResult{Versions: versions, Meta: ListMeta{TotalCount: totalCount}}, nil
+		return &UploadVersionListResult{Versions: versions, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages}}, nil
 	}
 
 	// Determine limit: 0 = all (default for versions)
@@ -973,7 +1160,7 @@ func (s *UploadsService) ListVersions(ctx context.Context, uploadID int64, opts
 
 	// Check if we already have enough items
 	if limit > 0 && len(versions) >= limit {
-		return &UploadVersionListResult{Versions: versions[:limit], Meta: ListMeta{TotalCount: totalCount, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(versions), limit)}}, nil
+		return &UploadVersionListResult{Versions: versions[:limit], Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(versions), limit)}}, nil
 	}
 
 	// Follow pagination via Link headers (uses absolute URLs from API, no path construction)
@@ -991,7 +1178,7 @@ func (s *UploadsService) ListVersions(ctx context.Context, uploadID int64, opts
 		versions = append(versions, uploadFromGenerated(gu))
 	}
 
-	return &UploadVersionListResult{Versions: versions, Meta: ListMeta{TotalCount: totalCount, Truncated: truncated}}, nil
+	return &UploadVersionListResult{Versions: versions, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: truncated}}, nil
 }
 
 // DownloadResult contains the result from downloading an upload.
@@ -1019,6 +1206,7 @@ func (s *UploadsService) Download(ctx context.Context, uploadID int64) (result *
 		Service: "Uploads", Operation: "Download",
 		ResourceType: "upload", IsMutation: false,
 		ResourceID: uploadID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -1050,6 +1238,51 @@ func (s *UploadsService) Download(ctx context.Context, uploadID int64) (result *
 	return result, nil
 }
 
+// DownloadToFile downloads an upload's file content to destPath.
+//
+// The content is streamed to a temporary file in the same directory as
+// destPath, which is renamed over destPath only once the download completes.
+// On failure destPath is left untouched. The file gets mode 0644, as
+// os.Create gives under the usual 022 umask, rather than the temporary file's
+// 0600. Operation hooks observe the underlying Download call.
+func (s *UploadsService) DownloadToFile(ctx context.Context, uploadID int64, destPath string) error {
+	if destPath == "" {
+		return ErrUsage("destination path is required")
+	}
+
+	result, err := s.Download(ctx, uploadID)
+	if err != nil {
+		return err
+	}
+	defer result.Body.Close()
+
+	tmp, err := os.CreateTemp(filepath.Dir(destPath), "."+filepath.Base(destPath)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create temp file: %w", err)
+	}
+	tmpName := tmp.Name()
+
+	if _, err := io.Copy(tmp, result.Body); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmpName) // #nosec G703 -- path derived from caller-supplied destPath
+		return fmt.Errorf("failed to write download: %w", err)
+	}
+	if err := tmp.Chmod(0o644); err != nil { // #nosec G302 -- downloads are ordinary user files
+		_ = tmp.Close()
+		_ = os.Remove(tmpName) // #nosec G703 -- path derived from caller-supplied destPath
+		return fmt.Errorf("failed to set download permissions: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmpName) // #nosec G703 -- path derived from caller-supplied destPath
+		return fmt.Errorf("failed to write download: %w", err)
+	}
+	if err := os.Rename(tmpName, destPath); err != nil { // #nosec G703 -- path derived from caller-supplied destPath
+		_ = os.Remove(tmpName) // #nosec G703 -- path derived from caller-supplied destPath
+		return fmt.Errorf("failed to move download into place: %w", err)
+	}
+	return nil
+}
+
 // vaultFromGenerated converts a generated Vault to our clean Vault type.
 func vaultFromGenerated(gv generated.Vault) Vault {
 	v := Vault{
@@ -3,15 +3,19 @@ package basecamp
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"os"
 	"path/filepath"
 	"reflect"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"testing"
+	"time"
 )
 
 func vaultsFixturesDir() string {
@@ -1003,3 +1007,338 @@ func TestUploadsService_Download_DirectBody(t *testing.T) {
 		t.Errorf("expected body %q, got %q", fileContent, string(body))
 	}
 }
+
+func TestDocumentsService_Copy(t *testing.T) {
+	var requests []string
+	var created map[string]any
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodGet:
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"id": 300, "title": "Launch plan", "content": "<div>Ship it</div>",
+			})
+		case http.MethodPost:
+			_ = json.NewDecoder(r.Body).Decode(&created)
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"id": 301, "title": created["title"], "content": created["content"],
+			})
+		}
+	}))
+	defer server.Close()
+
+	cfg := DefaultConfig()
+	cfg.BaseURL = server.URL
+	client := NewClient(cfg, &StaticTokenProvider{Token: "test-token"})
+
+	doc, err := client.ForAccount("12345").Documents().Copy(context.Background(), 300, 400)
+	if err != nil {
+		t.Fatalf("Copy() error = %v", err)
+	}
+
+	want := []string{"GET /12345/documents/300", "POST /12345/vaults/400/documents.json"}
+	if !reflect.DeepEqual(requests, want) {
+		t.Errorf("requests = %v, want %v", requests, want)
+	}
+	if created["title"] != "Launch plan" || created["content"] != "<div>Ship it</div>" {
+		t.Errorf("create body = %v, want source title and content", created)
+	}
+	if doc.ID != 301 || doc.Title != "Launch plan" {
+		t.Errorf("doc = %+v, want ID 301 titled Launch plan", doc)
+	}
+}
+
+func TestVaultsService_List_TotalPages(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Total-Count", "40")
+		w.Header().Set("Link", fmt.Sprintf(`<http://%s/99999/vaults/1/vaults.json?page=2>; rel="next"`, r.Host))
+		vaults := make([]map[string]any, 15)
+		for i := range vaults {
+			vaults[i] = map[string]any{"id": i + 1, "title": fmt.Sprintf("Vault %d", i+1)}
+		}
+		_ = json.NewEncoder(w).Encode(vaults)
+	}))
+	t.Cleanup(server.Close)
+
+	cfg := DefaultConfig()
+	cfg.BaseURL = server.URL
+	client := NewClient(cfg, &StaticTokenProvider{Token: "test-token"})
+
+	result, err := client.ForAccount("99999").Vaults().List(context.Background(), 1, &VaultListOptions{Page: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Meta.TotalCount != 40 || result.Meta.TotalPages != 3 {
+		t.Errorf("expected TotalCount 40 and TotalPages 3, got %+v", result.Meta)
+	}
+	if result.Meta.IsLastPage(1, len(result.Vaults)) {
+		t.Error("expected page 1 of 3 not to be the last page")
+	}
+}
+
+// vaultTreeServer serves a vault hierarchy: vault 1 holds vaults 10 and 11;
+// vault 10 holds documents 101 and 102 and vault 20; vault 11 holds uploads
+// 111 and 112. It records the paths requested and the peak number of
+// concurrent requests.
+type vaultTreeServer struct {
+	mu       sync.Mutex
+	paths    []string
+	inFlight atomic.Int32
+	peak     atomic.Int32
+	failPath string
+}
+
+func (v *vaultTreeServer) handler(t *testing.T) http.HandlerFunc {
+	vaults := map[string]string{
+		"1":  `[{"id":10,"title":"Specs"},{"id":11,"title":"Assets"}]`,
+		"10": `[{"id":20,"title":"Archive"}]`,
+	}
+	documents := map[string]string{
+		"10": `[{"id":101,"title":"Plan"},{"id":102,"title":"Notes"}]`,
+	}
+	uploads := map[string]string{
+		"11": `[{"id":111,"filename":"logo.png"},{"id":112,"filename":"icon.png"}]`,
+	}
+	return func(w http.ResponseWriter, r *http.Request) {
+		n := v.inFlight.Add(1)
+		defer v.inFlight.Add(-1)
+		for {
+			peak := v.peak.Load()
+			if n <= peak || v.peak.CompareAndSwap(peak, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+
+		v.mu.Lock()
+		v.paths = append(v.paths, r.URL.Path)
+		v.mu.Unlock()
+
+		if r.URL.Path == v.failPath {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/99999/vaults/"), "/")
+		id := parts[0]
+		if len(parts) == 1 {
+			fmt.Fprintf(w, `{"id":%s,"title":"Docs & Files"}`, id)
+			return
+		}
+		var body string
+		switch parts[1] {
+		case "vaults.json":
+			body = vaults[id]
+		case "documents.json":
+			body = documents[id]
+		case "uploads.json":
+			body = uploads[id]
+		default:
+			t.Errorf("unexpected request: %s", r.URL.Path)
+		}
+		if body == "" {
+			body = "[]"
+		}
+		w.Write([]byte(body))
+	}
+}
+
+func (v *vaultTreeServer) requested(path string) bool {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	for _, p := range v.paths {
+		if p == path {
+			return true
+		}
+	}
+	return false
+}
+
+func newVaultTreeService(t *testing.T, v *vaultTreeServer) *VaultsService {
+	t.Helper()
+	server := httptest.NewServer(v.handler(t))
+	t.Cleanup(server.Close)
+
+	cfg := DefaultConfig()
+	cfg.BaseURL = server.URL
+	client := NewClient(cfg, &StaticTokenProvider{Token: "test-token"})
+	return client.ForAccount("99999").Vaults()
+}
+
+func TestVaultsService_ListRecursive(t *testing.T) {
+	v := &vaultTreeServer{}
+	svc := newVaultTreeService(t, v)
+
+	tree, err := svc.ListRecursive(context.Background(), 1, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if tree.Vault.ID != 1 || len(tree.Children) != 2 {
+		t.Fatalf("expected root 1 with 2 children, got %d with %d", tree.Vault.ID, len(tree.Children))
+	}
+	specs, assets := tree.Children[0], tree.Children[1]
+	if specs.Vault.ID != 10 || assets.Vault.ID != 11 {
+		t.Fatalf("expected children 10 and 11, got %d and %d", specs.Vault.ID, assets.Vault.ID)
+	}
+	if len(specs.Documents) != 2 || specs.Documents[0].ID != 101 || specs.Documents[1].ID != 102 {
+		t.Errorf("expected documents 101 and 102 in vault 10, got %+v", specs.Documents)
+	}
+	if len(assets.Uploads) != 2 || assets.Uploads[0].ID != 111 || assets.Uploads[1].ID != 112 {
+		t.Errorf("expected uploads 111 and 112 in vault 11, got %+v", assets.Uploads)
+	}
+	if len(specs.Children) != 1 || specs.Children[0].Vault.ID != 20 {
+		t.Errorf("expected vault 20 under vault 10, got %+v", specs.Children)
+	}
+	if !v.requested("/99999/vaults/20/vaults.json") {
+		t.Error("expected the walk to reach vault 20")
+	}
+}
+
+func TestVaultsService_ListRecursive_MaxDepth(t *testing.T) {
+	v := &vaultTreeServer{}
+	svc := newVaultTreeService(t, v)
+
+	tree, err := svc.ListRecursive(context.Background(), 1, &RecursiveVaultOptions{MaxDepth: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(tree.Children) != 2 {
+		t.Fatalf("expected 2 children at depth 1, got %d", len(tree.Children))
+	}
+	specs := tree.Children[0]
+	if len(specs.Documents) != 2 {
+		t.Errorf("expected vault 10 documents to be listed at MaxDepth, got %d", len(specs.Documents))
+	}
+	if len(specs.Children) != 0 {
+		t.Errorf("expected no sub-vaults below MaxDepth, got %d", len(specs.Children))
+	}
+	if v.requested("/99999/vaults/10/vaults.json") {
+		t.Error("expected no sub-vault listing at MaxDepth")
+	}
+}
+
+func TestVaultsService_ListRecursive_Concurrency(t *testing.T) {
+	v := &vaultTreeServer{}
+	svc := newVaultTreeService(t, v)
+
+	if _, err := svc.ListRecursive(context.Background(), 1, &RecursiveVaultOptions{Concurrency: 2}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if peak := v.peak.Load(); peak > 2 {
+		t.Errorf("expected at most 2 concurrent requests, got %d", peak)
+	}
+}
+
+func TestVaultsService_ListRecursive_Error(t *testing.T) {
+	v := &vaultTreeServer{failPath: "/99999/vaults/11/uploads.json"}
+	svc := newVaultTreeService(t, v)
+
+	tree, err := svc.ListRecursive(context.Background(), 1, nil)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if tree != nil {
+		t.Error("expected no tree on error")
+	}
+	if !strings.Contains(err.Error(), "vault 11") {
+		t.Errorf("expected error to name vault 11, got %v", err)
+	}
+}
+
+// testDocumentsPagesServer serves three pages of documents linked by Link
+// headers and records each request's raw query.
+func testDocumentsPagesServer(t *testing.T, queries *[]string) *DocumentsService {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*queries = append(*queries, r.URL.RawQuery)
+		page := r.URL.Query().Get("page")
+		if page == "" {
+			page = "1"
+		}
+		if page == "1" || page == "2" {
+			next := map[string]string{"1": "2", "2": "3"}[page]
+			w.Header().Set("Link", fmt.Sprintf(`<http://%s/12345/vaults/400/documents.json?page=%s>; rel="next"`, r.Host, next))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `[{"id":%s01,"title":"page %s","creator":{"id":%s,"name":"Author %s"}}]`, page, page, page, page)
+	}))
+	t.Cleanup(server.Close)
+
+	cfg := DefaultConfig()
+	cfg.BaseURL = server.URL
+	client := NewClient(cfg, &StaticTokenProvider{Token: "test-token"})
+	return client.ForAccount("12345").Documents()
+}
+
+func TestDocumentsService_List_CreatorID(t *testing.T) {
+	var queries []string
+	svc := testDocumentsPagesServer(t, &queries)
+
+	creatorID := int64(2)
+	result, err := svc.List(context.Background(), 400, &DocumentListOptions{CreatorID: &creatorID, Limit: 1})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(queries) != 3 {
+		t.Errorf("expected every page to be fetched, got %d requests", len(queries))
+	}
+	for _, q := range queries {
+		if v, _ := url.ParseQuery(q); v.Has("creator_id") {
+			t.Errorf("query = %q, want no creator_id parameter", q)
+		}
+	}
+	if len(result.Documents) != 1 || result.Documents[0].ID != 201 {
+		t.Errorf("documents = %+v, want only document 201", result.Documents)
+	}
+
+	onePage, err := svc.List(context.Background(), 400, &DocumentListOptions{CreatorID: &creatorID, Page: 1})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(onePage.Documents) != 0 {
+		t.Errorf("documents = %+v, want none on page 1", onePage.Documents)
+	}
+}
+
+func TestDocumentsService_List_PageReturnsFirstPageOnly(t *testing.T) {
+	var queries []string
+	svc := testDocumentsPagesServer(t, &queries)
+
+	result, err := svc.List(context.Background(), 400, &DocumentListOptions{Page: 2})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if !reflect.DeepEqual(queries, []string{""}) {
+		t.Errorf("queries = %v, want a single unparameterized request", queries)
+	}
+	if len(result.Documents) != 1 || result.Documents[0].ID != 101 {
+		t.Errorf("documents = %+v, want only document 101", result.Documents)
+	}
+}
+
+func TestDocumentsService_ListAll(t *testing.T) {
+	var queries []string
+	svc := testDocumentsPagesServer(t, &queries)
+
+	docs, err := svc.ListAll(context.Background(), 400, nil)
+	if err != nil {
+		t.Fatalf("ListAll() error = %v", err)
+	}
+	if len(queries) != 3 {
+		t.Errorf("expected 3 requests, got %d", len(queries))
+	}
+	var ids []int64
+	for _, d := range docs {
+		ids = append(ids, d.ID)
+	}
+	if !reflect.DeepEqual(ids, []int64{101, 201, 301}) {
+		t.Errorf("document IDs = %v, want [101 201 301]", ids)
+	}
+}
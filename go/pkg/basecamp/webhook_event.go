@@ -104,6 +104,8 @@ const (
 	WebhookTypeForwardReply   = "Forward::Reply"
 	WebhookTypeGoogleDocument = "GoogleDocument"
 	WebhookTypeInboxForward   = "Inbox::Forward"
+	WebhookTypeKanbanCard     = "Kanban::Card"
+	WebhookTypeKanbanStep     = "Kanban::Step"
 	WebhookTypeMessage        = "Message"
 	WebhookTypeQuestion       = "Question"
 	WebhookTypeQuestionAnswer = "Question::Answer"
@@ -57,8 +57,8 @@ type WebhookDeliveryResponse struct {
 type CreateWebhookRequest struct {
 	// PayloadURL is the URL to receive webhook payloads (required).
 	PayloadURL string `json:"payload_url"`
-	// Types is a list of event types to subscribe to (required).
-	// Example: ["Todo", "Todolist", "Comment"]
+	// Types is a list of recording types to subscribe to (required), such
+	// as the WebhookType constants. Example: ["Todo", "Todolist", "Comment"]
 	Types []string `json:"types"`
 	// Active indicates whether the webhook is active (default: true).
 	Active *bool `json:"active,omitempty"`
@@ -68,7 +68,8 @@ type CreateWebhookRequest struct {
 type UpdateWebhookRequest struct {
 	// PayloadURL is the URL to receive webhook payloads.
 	PayloadURL string `json:"payload_url,omitempty"`
-	// Types is a list of event types to subscribe to.
+	// Types is a list of recording types to subscribe to, such as the
+	// WebhookType constants.
 	Types []string `json:"types,omitempty"`
 	// Active indicates whether the webhook is active.
 	Active *bool `json:"active,omitempty"`
@@ -104,6 +105,7 @@ func (s *WebhooksService) List(ctx context.Context, bucketID int64, opts *Webhoo
 	op := OperationInfo{
 		Service: "Webhooks", Operation: "List",
 		ResourceType: "webhook", IsMutation: false,
+		AccountID: s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -124,6 +126,7 @@ func (s *WebhooksService) List(ctx context.Context, bucketID int64, opts *Webhoo
 
 	// Capture total count from X-Total-Count header
 	totalCount := parseTotalCount(resp.HTTPResponse)
+	totalPages := parseTotalPages(resp.HTTPResponse, firstPageLen(resp.JSON200))
 
 	// Parse first page
 	var webhooks []Webhook
@@ -135,7 +138,7 @@ func (s *WebhooksService) List(ctx context.Context, bucketID int64, opts *Webhoo
 
 	// Handle single page fetch (--page flag)
 	if opts != nil && opts.Page > 0 {
-		return &WebhookListResult{Webhooks: webhooks, Meta: ListMeta{TotalCount: totalCount}}, nil
+		return &WebhookListResult{Webhooks: webhooks, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages}}, nil
 	}
 
 	// Determine limit: 0 = all (no limit)
@@ -150,7 +153,7 @@ func (s *WebhooksService) List(ctx context.Context, bucketID int64, opts *Webhoo
 
 	// Check if we already have enough items
 	if limit > 0 && len(webhooks) >= limit {
-		return &WebhookListResult{Webhooks: webhooks[:limit], Meta: ListMeta{TotalCount: totalCount, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(webhooks), limit)}}, nil
+		return &WebhookListResult{Webhooks: webhooks[:limit], Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: isFirstPageTruncated(resp.HTTPResponse, len(webhooks), limit)}}, nil
 	}
 
 	// Follow pagination via Link headers
@@ -168,7 +171,7 @@ func (s *WebhooksService) List(ctx context.Context, bucketID int64, opts *Webhoo
 		webhooks = append(webhooks, webhookFromGenerated(gw))
 	}
 
-	return &WebhookListResult{Webhooks: webhooks, Meta: ListMeta{TotalCount: totalCount, Truncated: truncated}}, nil
+	return &WebhookListResult{Webhooks: webhooks, Meta: ListMeta{TotalCount: totalCount, TotalPages: totalPages, Truncated: truncated}}, nil
 }
 
 // Get returns a webhook by ID.
@@ -177,6 +180,7 @@ func (s *WebhooksService) Get(ctx context.Context, webhookID int64) (result *Web
 		Service: "Webhooks", Operation: "Get",
 		ResourceType: "webhook", IsMutation: false,
 		ResourceID: webhookID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -209,6 +213,7 @@ func (s *WebhooksService) Create(ctx context.Context, bucketID int64, req *Creat
 	op := OperationInfo{
 		Service: "Webhooks", Operation: "Create",
 		ResourceType: "webhook", IsMutation: true,
+		AccountID: s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -266,6 +271,7 @@ func (s *WebhooksService) Update(ctx context.Context, webhookID int64, req *Upda
 		Service: "Webhooks", Operation: "Update",
 		ResourceType: "webhook", IsMutation: true,
 		ResourceID: webhookID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
@@ -310,12 +316,46 @@ func (s *WebhooksService) Update(ctx context.Context, webhookID int64, req *Upda
 	return &webhook, nil
 }
 
+// Upsert creates a webhook for payloadURL in a project (bucket), or updates
+// the existing one if the project already has a webhook for that exact URL.
+// An existing webhook has its types replaced with types; its active flag is
+// left unchanged. Returns the created or updated webhook.
+//
+// Upsert is a composite of List (all pages) followed by Update or Create. It
+// does not emit its own operation hooks; hooks observe each underlying call.
+// It is not atomic: a webhook created concurrently for the same URL between
+// the list and the create can still produce a duplicate.
+func (s *WebhooksService) Upsert(ctx context.Context, bucketID int64, payloadURL string, types []string) (*Webhook, error) {
+	if payloadURL == "" {
+		return nil, ErrUsage("webhook payload_url is required")
+	}
+	if err := requireHTTPS(payloadURL); err != nil {
+		return nil, ErrUsage("webhook payload_url must use HTTPS")
+	}
+	if len(types) == 0 {
+		return nil, ErrUsage("webhook types are required")
+	}
+
+	existing, err := s.List(ctx, bucketID, &WebhookListOptions{Limit: -1})
+	if err != nil {
+		return nil, err
+	}
+	for _, w := range existing.Webhooks {
+		if w.PayloadURL == payloadURL {
+			return s.Update(ctx, w.ID, &UpdateWebhookRequest{Types: types})
+		}
+	}
+
+	return s.Create(ctx, bucketID, &CreateWebhookRequest{PayloadURL: payloadURL, Types: types})
+}
+
 // Delete removes a webhook.
 func (s *WebhooksService) Delete(ctx context.Context, webhookID int64) (err error) {
 	op := OperationInfo{
 		Service: "Webhooks", Operation: "Delete",
 		ResourceType: "webhook", IsMutation: true,
 		ResourceID: webhookID,
+		AccountID:  s.client.accountID,
 	}
 	if gater, ok := s.client.parent.hooks.(GatingHooks); ok {
 		if ctx, err = gater.OnOperationGate(ctx, op); err != nil {
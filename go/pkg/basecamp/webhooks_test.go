@@ -1,9 +1,16 @@
 package basecamp
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -317,3 +324,106 @@ func TestWebhook_TimestampParsing(t *testing.T) {
 		t.Errorf("expected year 2022, got %d", webhook.CreatedAt.Year())
 	}
 }
+
+// webhookUpsertServer serves a paginated webhook list (the list.json fixture
+// on page 1 and extra on page 2) and records mutating requests.
+func webhookUpsertServer(t *testing.T, extra string, mutations *[]string) *WebhooksService {
+	t.Helper()
+	list := loadWebhooksFixture(t, "list.json")
+	get := loadWebhooksFixture(t, "get.json")
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/99999/buckets/100/webhooks.json":
+			if r.URL.Query().Get("page") == "2" {
+				w.Write([]byte(extra))
+				return
+			}
+			w.Header().Set("Link", fmt.Sprintf(`<%s/99999/buckets/100/webhooks.json?page=2>; rel="next"`, server.URL))
+			w.Write(list)
+		case r.Method == "POST" && r.URL.Path == "/99999/buckets/100/webhooks.json":
+			body, _ := io.ReadAll(r.Body)
+			*mutations = append(*mutations, "POST "+string(body))
+			w.WriteHeader(201)
+			w.Write(get)
+		case r.Method == "PUT":
+			body, _ := io.ReadAll(r.Body)
+			*mutations = append(*mutations, "PUT "+r.URL.Path+" "+string(body))
+			w.Write(get)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.String())
+			w.WriteHeader(500)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	cfg := DefaultConfig()
+	cfg.BaseURL = server.URL
+	client := NewClient(cfg, &StaticTokenProvider{Token: "test-token"})
+	return client.ForAccount("99999").Webhooks()
+}
+
+func TestWebhooksService_Upsert_CreatesWhenNoMatch(t *testing.T) {
+	var mutations []string
+	svc := webhookUpsertServer(t, `[]`, &mutations)
+
+	_, err := svc.Upsert(context.Background(), 100, "https://example.com/webhooks/new", []string{"Todo"})
+	if err != nil {
+		t.Fatalf("Upsert() error = %v", err)
+	}
+
+	if len(mutations) != 1 || !strings.HasPrefix(mutations[0], "POST ") {
+		t.Fatalf("mutations = %v, want a single POST", mutations)
+	}
+	var body map[string]any
+	if err := json.Unmarshal([]byte(strings.TrimPrefix(mutations[0], "POST ")), &body); err != nil {
+		t.Fatalf("failed to decode body: %v", err)
+	}
+	if body["payload_url"] != "https://example.com/webhooks/new" {
+		t.Errorf("payload_url = %v", body["payload_url"])
+	}
+}
+
+func TestWebhooksService_Upsert_UpdatesExistingMatch(t *testing.T) {
+	var mutations []string
+	svc := webhookUpsertServer(t, `[]`, &mutations)
+
+	_, err := svc.Upsert(context.Background(), 100, "https://example.com/webhooks/comments", []string{"Comment", "Todo"})
+	if err != nil {
+		t.Fatalf("Upsert() error = %v", err)
+	}
+
+	want := `PUT /99999/webhooks/9007199254741434 {"types":["Comment","Todo"]}`
+	if len(mutations) != 1 || mutations[0] != want {
+		t.Errorf("mutations = %v, want [%s]", mutations, want)
+	}
+}
+
+func TestWebhooksService_Upsert_FindsMatchOnLaterPage(t *testing.T) {
+	var mutations []string
+	extra := `[{"id": 77, "payload_url": "https://example.com/webhooks/deploys", "types": ["Todo"]}]`
+	svc := webhookUpsertServer(t, extra, &mutations)
+
+	// Neither webhook on page 1 matches; the one on page 2 does.
+	_, err := svc.Upsert(context.Background(), 100, "https://example.com/webhooks/deploys", []string{"Message"})
+	if err != nil {
+		t.Fatalf("Upsert() error = %v", err)
+	}
+
+	want := `PUT /99999/webhooks/77 {"types":["Message"]}`
+	if len(mutations) != 1 || mutations[0] != want {
+		t.Errorf("mutations = %v, want [%s]", mutations, want)
+	}
+}
+
+func TestWebhooksService_Upsert_RequiresHTTPS(t *testing.T) {
+	svc := webhookUpsertServer(t, `[]`, new([]string))
+
+	_, err := svc.Upsert(context.Background(), 100, "http://example.com/webhook", []string{"Todo"})
+	apiErr, ok := errors.AsType[*Error](err)
+	if !ok || apiErr.Code != CodeUsage {
+		t.Errorf("expected usage error, got: %v", err)
+	}
+}
@@ -3312,6 +3312,13 @@ func isRetryableStatus(statusCode int) bool {
 	}
 }
 
+// isPermanentError reports whether err, or an error it wraps, has a
+// Permanent method that returns true. Such errors are never retried.
+func isPermanentError(err error) bool {
+	var p interface{ Permanent() bool }
+	return errors.As(err, &p) && p.Permanent()
+}
+
 // doWithRetry executes a request with retry logic for idempotent operations.
 func (c *Client) doWithRetry(ctx context.Context, buildRequest func() (*http.Request, error), isIdempotent bool, operationId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	maxAttempts := 1
@@ -3343,8 +3350,9 @@ func (c *Client) doWithRetry(ctx context.Context, buildRequest func() (*http.Req
 					"error", err,
 				)
 			}
-			// Network errors are retryable for idempotent operations
-			if isIdempotent && attempt < maxAttempts {
+			// Network errors are retryable for idempotent operations,
+			// unless the transport marked the error as permanent
+			if isIdempotent && attempt < maxAttempts && !isPermanentError(err) {
 				select {
 				case <-ctx.Done():
 					return nil, ctx.Err()
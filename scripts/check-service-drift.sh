@@ -21,8 +21,6 @@ SERVICE_DIR="$SDK_DIR/pkg/basecamp"
 EXCLUDED_OPS=(
   GetQuestionReminders
   ListQuestionAnswerers
-  PauseQuestion
-  ResumeQuestion
   SubscribeToCardColumn
   UnsubscribeFromCardColumn
   UpdateQuestionNotificationSettings